@@ -0,0 +1,39 @@
+package collection
+
+// Bind keeps the slice pointed to by dst synchronized with the collection. dst
+// is updated immediately, and again after every change made through one of the
+// collection's pointer receiver methods, such as Set or Pop.
+//
+// Bindings are copied along with the collection value, but are not carried over
+// to new collections returned by methods such as Append or Filter.
+func (c *Collection[T]) Bind(dst *[]T) {
+	c.BindFunc(func(values []T) {
+		*dst = values
+	})
+}
+
+// BindFunc calls fn with the collection's underlying data immediately, and again
+// after every change made through one of the collection's pointer receiver
+// methods. It can be used to keep a config struct field, or any other external
+// state, synchronized with the collection.
+func (c *Collection[T]) BindFunc(fn func(values []T)) {
+	c.bindings = append(c.bindings, fn)
+	fn(c.contents)
+}
+
+// sync records a change to the collection for fail-fast mode, passes the
+// collection's underlying data to each of its bindings, and invalidates any
+// derived collections.
+func (c *Collection[T]) sync() {
+	if c.mods != nil {
+		c.mods.Add(1)
+	}
+
+	for name := range c.derived {
+		delete(c.derived, name)
+	}
+
+	for _, fn := range c.bindings {
+		fn(c.contents)
+	}
+}
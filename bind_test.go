@@ -0,0 +1,52 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBind(t *testing.T) {
+	var dst []int
+
+	col := collection.From([]int{1, 2, 3, 4})
+	col.Bind(&dst)
+	assert.Equal(t, []int{1, 2, 3, 4}, dst)
+
+	col.Pop(1)
+	assert.Equal(t, []int{1, 2, 3}, dst)
+
+	col.Set(5, 6)
+	assert.Equal(t, []int{1, 2, 3, 0, 0, 6}, dst)
+
+	col.FilterInPlace(func(i int, value int) bool {
+		return value != 0
+	})
+	assert.Equal(t, []int{1, 2, 3, 6}, dst)
+
+	appended := col.Append(7)
+	appended.Set(4, 9)
+	assert.Equal(t, []int{1, 2, 3, 6}, dst)
+}
+
+func TestBindFunc(t *testing.T) {
+	config := struct {
+		Hosts []string
+		Count int
+	}{}
+
+	col := collection.From([]string{"a", "b"})
+	col.BindFunc(func(values []string) {
+		config.Hosts = values
+		config.Count = len(values)
+	})
+	assert.Equal(t, 2, config.Count)
+
+	col.ReverseInPlace()
+	assert.Equal(t, []string{"b", "a"}, config.Hosts)
+
+	col.Pop(1)
+	assert.Equal(t, []string{"b"}, config.Hosts)
+	assert.Equal(t, 1, config.Count)
+}
@@ -0,0 +1,71 @@
+package collection
+
+// boundsMode controls how a collection handles indices that are out of range.
+type boundsMode int
+
+const (
+	// defaultBounds keeps each method's own behaviour: At returns a zero
+	// value, Set grows the collection, and Before and After panic.
+	defaultBounds boundsMode = iota
+	strictBounds
+	clampBounds
+)
+
+// CollectionOption configures a collection created by New.
+type CollectionOption func(*collectionConfig)
+
+type collectionConfig struct {
+	bounds boundsMode
+}
+
+// WithStrictBounds makes At, Set, Before and After panic with an *IndexError
+// wrapping collection.ErrIndexOutOfRange when given an index that is out of range,
+// rather than returning a zero value or growing the collection.
+func WithStrictBounds() CollectionOption {
+	return func(c *collectionConfig) {
+		c.bounds = strictBounds
+	}
+}
+
+// WithClamping makes At, Set, Before and After treat an index that is out of
+// range as the nearest index that is in range, so At(-1) returns the first
+// item and At(100) of a collection of 3 items returns the last.
+func WithClamping() CollectionOption {
+	return func(c *collectionConfig) {
+		c.bounds = clampBounds
+	}
+}
+
+// New returns a new collection from the provided slice, in the same way as
+// From, configured with the given options. The options are kept by
+// collections returned by Append.
+func New[T comparable](slice []T, opts ...CollectionOption) Collection[T] {
+	var cfg collectionConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	c := From(slice)
+	c.bounds = cfg.bounds
+
+	return c
+}
+
+// bound applies the collection's bounds mode to the index i, where the valid
+// indices are 0 to max inclusive. In strict mode, an out of range index panics
+// with an *IndexError. In clamping mode, an empty collection has no index to
+// clamp to, so i is returned unchanged.
+func (c Collection[T]) bound(i int, max int) int {
+	switch c.bounds {
+	case strictBounds:
+		if i < 0 || i > max {
+			panic(&IndexError{Index: i, Len: c.Count(), Err: ErrIndexOutOfRange})
+		}
+	case clampBounds:
+		if max >= 0 {
+			return clamp(i, 0, max)
+		}
+	}
+
+	return i
+}
@@ -0,0 +1,60 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictBounds(t *testing.T) {
+	c := collection.New([]int{1, 2, 3}, collection.WithStrictBounds())
+
+	assert.Equal(t, 3, c.At(2))
+	assert.Equal(t, []int{1, 2}, c.Before(2).All())
+
+	assert.PanicsWithError(t, "index out of range: index 3, length 3", func() {
+		c.At(3)
+	})
+	assert.Panics(t, func() {
+		c.Set(5, 1)
+	})
+	assert.Panics(t, func() {
+		c.Before(4)
+	})
+	assert.Panics(t, func() {
+		c.After(-1)
+	})
+
+	appended := c.Append(4)
+	assert.Panics(t, func() {
+		appended.At(4)
+	})
+}
+
+func TestClampedBounds(t *testing.T) {
+	c := collection.New([]int{1, 2, 3}, collection.WithClamping())
+
+	assert.Equal(t, 1, c.At(-5))
+	assert.Equal(t, 3, c.At(10))
+	assert.Equal(t, []int{1, 2, 3}, c.Before(10).All())
+	assert.Equal(t, []int{1, 2, 3}, c.After(-1).All())
+
+	c.Set(10, 9)
+	assert.Equal(t, []int{1, 2, 9}, c.All())
+
+	empty := collection.New([]int{}, collection.WithClamping())
+	assert.Equal(t, 0, empty.At(3))
+}
+
+func TestDefaultBounds(t *testing.T) {
+	c := collection.From([]int{1, 2, 3})
+
+	assert.Equal(t, 0, c.At(3))
+	assert.Equal(t, 0, c.At(-1))
+
+	c.Set(3, 4)
+	assert.Equal(t, []int{1, 2, 3, 4}, c.All())
+
+	assert.ErrorIs(t, c.SafeSet(4, 5), collection.ErrIndexOutOfRange)
+}
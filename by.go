@@ -0,0 +1,171 @@
+package collection
+
+import "sort"
+
+// UniqueBy returns the items of the collection with a unique key, keeping the
+// first item with each key. This is useful where items are identified by one
+// of their fields, such as an ID, rather than by their whole value.
+func UniqueBy[T comparable, K comparable](c Collection[T], key func(v T) K) Collection[T] {
+	seen := make(map[K]struct{}, c.Count())
+
+	return c.Filter(func(i int, v T) bool {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+
+		seen[k] = struct{}{}
+		return true
+	})
+}
+
+// SortBy returns a copy of the collection sorted in ascending order of the key
+// returned for each item by the key func. Items with equal keys keep their
+// original order.
+func SortBy[T comparable, K ordered](c Collection[T], key func(v T) K) Collection[T] {
+	return SortByKeys(c, Ascending(key))
+}
+
+// SortByDesc works in the same way as SortBy, but sorts in descending order.
+func SortByDesc[T comparable, K ordered](c Collection[T], key func(v T) K) Collection[T] {
+	return SortByKeys(c, Descending(key))
+}
+
+// Comparator compares two items, returning a negative number if a sorts before
+// b, a positive number if a sorts after b, and zero if they are equal.
+type Comparator[T any] func(a T, b T) int
+
+// Ascending returns a Comparator that sorts items in ascending order of key.
+func Ascending[T any, K ordered](key func(v T) K) Comparator[T] {
+	return func(a T, b T) int {
+		ka, kb := key(a), key(b)
+		switch {
+		case ka < kb:
+			return -1
+		case ka > kb:
+			return 1
+		}
+
+		return 0
+	}
+}
+
+// Descending returns a Comparator that sorts items in descending order of key.
+func Descending[T any, K ordered](key func(v T) K) Comparator[T] {
+	asc := Ascending(key)
+
+	return func(a T, b T) int {
+		return asc(b, a)
+	}
+}
+
+// SortByKeys returns a copy of the collection sorted by each of the given
+// Comparators in turn, so that items the first Comparator finds equal are
+// sorted by the second, and so on. Items that every Comparator finds equal keep
+// their original order. For example, to sort by last name, then by age from
+// oldest to youngest:
+//
+//	SortByKeys(people, Ascending(lastName), Descending(age))
+func SortByKeys[T comparable](c Collection[T], keys ...Comparator[T]) Collection[T] {
+	sorted := c.Clone()
+	items := sorted.contents
+
+	sort.SliceStable(items, func(i int, j int) bool {
+		for _, cmp := range keys {
+			if n := cmp(items[i], items[j]); n != 0 {
+				return n < 0
+			}
+		}
+
+		return false
+	})
+
+	return sorted
+}
+
+// MinBy returns the item for which the key func returns the smallest key,
+// keeping the first such item if there is a tie. If the collection is empty, a
+// zero value and collection.ErrNoItem are returned.
+func MinBy[T comparable, K ordered](c Collection[T], key func(v T) K) (T, error) {
+	return extremeBy(c, key, func(a K, b K) bool {
+		return a < b
+	})
+}
+
+// MaxBy returns the item for which the key func returns the largest key,
+// keeping the first such item if there is a tie. If the collection is empty, a
+// zero value and collection.ErrNoItem are returned.
+func MaxBy[T comparable, K ordered](c Collection[T], key func(v T) K) (T, error) {
+	return extremeBy(c, key, func(a K, b K) bool {
+		return a > b
+	})
+}
+
+// extremeBy returns the first item whose key is better than the key of every
+// item before it.
+func extremeBy[T comparable, K ordered](c Collection[T], key func(v T) K, better func(a K, b K) bool) (T, error) {
+	if c.Empty() {
+		return *new(T), ErrNoItem
+	}
+
+	best, bestKey := c.contents[0], key(c.contents[0])
+	for _, v := range c.contents[1:] {
+		if k := key(v); better(k, bestKey) {
+			best, bestKey = v, k
+		}
+	}
+
+	return best, nil
+}
+
+// SumBy returns the total of the numbers returned by fn for each item, such as
+// the total of one of their fields.
+func SumBy[T comparable, N numeric](c Collection[T], fn func(v T) N) N {
+	var total N = 0
+	for _, v := range c.contents {
+		total = total + fn(v)
+	}
+
+	return total
+}
+
+// AverageBy returns the mean average of the numbers returned by fn for each
+// item. As with NumericCollection's Average, the average of an empty
+// collection is NaN.
+func AverageBy[T comparable, N numeric](c Collection[T], fn func(v T) N) float64 {
+	return float64(SumBy(c, fn)) / float64(c.Count())
+}
+
+// DiffBy returns the items from the collection whose key isn't the key of any
+// item in other. It works in the same way as Diff, but compares items by the
+// key returned by the key func, such as an ID, rather than by their whole
+// value.
+func DiffBy[T comparable, K comparable](c Collection[T], other Collection[T], key func(v T) K) Collection[T] {
+	keys := keySet(other, key)
+
+	return c.Filter(func(i int, v T) bool {
+		_, found := keys[key(v)]
+		return !found
+	})
+}
+
+// IntersectBy returns the items from the collection whose key is also the key
+// of an item in other, comparing items by the key returned by the key func.
+func IntersectBy[T comparable, K comparable](c Collection[T], other Collection[T], key func(v T) K) Collection[T] {
+	keys := keySet(other, key)
+
+	return c.Filter(func(i int, v T) bool {
+		_, found := keys[key(v)]
+		return found
+	})
+}
+
+// keySet returns the set of keys of the items in the collection.
+func keySet[T comparable, K comparable](c Collection[T], key func(v T) K) map[K]struct{} {
+	keys := make(map[K]struct{}, c.Count())
+	for _, v := range c.contents {
+		keys[key(v)] = struct{}{}
+	}
+
+	return keys
+}
@@ -0,0 +1,108 @@
+package collection_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	ID       int
+	Customer string
+	Total    float64
+}
+
+var orders = []order{
+	{ID: 1, Customer: "ann", Total: 20},
+	{ID: 2, Customer: "bob", Total: 35.5},
+	{ID: 3, Customer: "ann", Total: 12},
+	{ID: 4, Customer: "cat", Total: 35.5},
+}
+
+func TestUniqueBy(t *testing.T) {
+	first := collection.UniqueBy(collection.From(orders), func(o order) string {
+		return o.Customer
+	})
+
+	assert.Equal(t, []order{orders[0], orders[1], orders[3]}, first.All())
+}
+
+func TestSortBy(t *testing.T) {
+	total := func(o order) float64 {
+		return o.Total
+	}
+
+	asc := collection.SortBy(collection.From(orders), total)
+	assert.Equal(t, []order{orders[2], orders[0], orders[1], orders[3]}, asc.All())
+
+	desc := collection.SortByDesc(collection.From(orders), total)
+	assert.Equal(t, []order{orders[1], orders[3], orders[0], orders[2]}, desc.All())
+
+	assert.Equal(t, 1, collection.From(orders).First().ID)
+}
+
+func TestSortByNamedType(t *testing.T) {
+	sorted := collection.SortBy(collection.From([]time.Duration{time.Hour, time.Second, time.Minute}), func(d time.Duration) time.Duration {
+		return d
+	})
+
+	assert.Equal(t, []time.Duration{time.Second, time.Minute, time.Hour}, sorted.All())
+}
+
+func TestSortByKeys(t *testing.T) {
+	sorted := collection.SortByKeys(collection.From(orders),
+		collection.Ascending(func(o order) string {
+			return o.Customer
+		}),
+		collection.Descending(func(o order) int {
+			return o.ID
+		}),
+	)
+
+	assert.Equal(t, []order{orders[2], orders[0], orders[1], orders[3]}, sorted.All())
+}
+
+func TestMinByAndMaxBy(t *testing.T) {
+	total := func(o order) float64 {
+		return o.Total
+	}
+
+	min, err := collection.MinBy(collection.From(orders), total)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, min.ID)
+
+	max, err := collection.MaxBy(collection.From(orders), total)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, max.ID)
+
+	_, err = collection.MaxBy(collection.Make[order](), total)
+	assert.ErrorIs(t, err, collection.ErrNoItem)
+}
+
+func TestSumByAndAverageBy(t *testing.T) {
+	total := func(o order) float64 {
+		return o.Total
+	}
+	id := func(o order) int {
+		return o.ID
+	}
+
+	assert.Equal(t, 103.0, collection.SumBy(collection.From(orders), total))
+	assert.Equal(t, 10, collection.SumBy(collection.From(orders), id))
+	assert.Equal(t, 25.75, collection.AverageBy(collection.From(orders), total))
+	assert.True(t, math.IsNaN(collection.AverageBy(collection.Make[order](), id)))
+}
+
+func TestDiffByAndIntersectBy(t *testing.T) {
+	customer := func(o order) string {
+		return o.Customer
+	}
+
+	seen := collection.From([]order{{ID: 9, Customer: "ann"}})
+
+	assert.Equal(t, []order{orders[1], orders[3]}, collection.DiffBy(collection.From(orders), seen, customer).All())
+	assert.Equal(t, []order{orders[0], orders[2]}, collection.IntersectBy(collection.From(orders), seen, customer).All())
+}
@@ -0,0 +1,42 @@
+package collection
+
+// Tap calls fn with the collection and returns the collection unchanged, so that
+// logging, metrics or assertions can be added to the middle of a chain of calls.
+func (c Collection[T]) Tap(fn func(c Collection[T])) Collection[T] {
+	fn(c)
+	return c
+}
+
+// Pipe passes the collection through each of the given funcs in order, with each
+// func receiving the result of the one before, and returns the final result.
+// This allows reusable transformations to be composed into a pipeline.
+func (c Collection[T]) Pipe(fns ...func(c Collection[T]) Collection[T]) Collection[T] {
+	for _, fn := range fns {
+		c = fn(c)
+	}
+
+	return c
+}
+
+// When returns the result of passing the collection to fn if cond is true, and
+// the collection unchanged otherwise.
+func (c Collection[T]) When(cond bool, fn func(c Collection[T]) Collection[T]) Collection[T] {
+	if cond {
+		return fn(c)
+	}
+
+	return c
+}
+
+// Unless returns the result of passing the collection to fn if cond is false,
+// and the collection unchanged otherwise.
+func (c Collection[T]) Unless(cond bool, fn func(c Collection[T]) Collection[T]) Collection[T] {
+	return c.When(!cond, fn)
+}
+
+// WhenEmpty returns the result of passing the collection to fn if the collection
+// is empty, and the collection unchanged otherwise. This is useful for
+// providing default items.
+func (c Collection[T]) WhenEmpty(fn func(c Collection[T]) Collection[T]) Collection[T] {
+	return c.When(c.Empty(), fn)
+}
@@ -0,0 +1,66 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTap(t *testing.T) {
+	seen := 0
+	res := collection.FromRange(1, 6).
+		Filter(func(i int, value int) bool {
+			return value%2 == 0
+		}).
+		Tap(func(c collection.Collection[int]) {
+			seen = c.Count()
+		}).
+		Map(func(i int, value int) int {
+			return value * 10
+		})
+
+	assert.Equal(t, 3, seen)
+	assert.Equal(t, []int{20, 40, 60}, res.All())
+}
+
+func TestPipe(t *testing.T) {
+	evens := func(c collection.Collection[int]) collection.Collection[int] {
+		return c.Filter(func(i int, value int) bool {
+			return value%2 == 0
+		})
+	}
+	double := func(c collection.Collection[int]) collection.Collection[int] {
+		return c.Map(func(i int, value int) int {
+			return value * 2
+		})
+	}
+
+	col := collection.FromRange(1, 6).Collection
+
+	assert.Equal(t, []int{4, 8, 12}, col.Pipe(evens, double).All())
+	assert.Equal(t, []int{2, 4, 6, 8, 10, 12}, col.Pipe(double, evens).All())
+	assert.Equal(t, col.All(), col.Pipe().All())
+}
+
+func TestWhenAndUnless(t *testing.T) {
+	reverse := func(c collection.Collection[int]) collection.Collection[int] {
+		return c.Reverse()
+	}
+
+	col := collection.From([]int{1, 2, 3})
+
+	assert.Equal(t, []int{3, 2, 1}, col.When(true, reverse).All())
+	assert.Equal(t, []int{1, 2, 3}, col.When(false, reverse).All())
+	assert.Equal(t, []int{1, 2, 3}, col.Unless(true, reverse).All())
+	assert.Equal(t, []int{3, 2, 1}, col.Unless(false, reverse).All())
+}
+
+func TestWhenEmpty(t *testing.T) {
+	defaults := func(c collection.Collection[string]) collection.Collection[string] {
+		return c.Append("default")
+	}
+
+	assert.Equal(t, []string{"default"}, collection.Make[string]().WhenEmpty(defaults).All())
+	assert.Equal(t, []string{"a"}, collection.From([]string{"a"}).WhenEmpty(defaults).All())
+}
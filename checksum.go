@@ -0,0 +1,93 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash"
+)
+
+// Checksum is the hash of one chunk of a collection, as returned by
+// ChunkChecksums.
+type Checksum struct {
+	// Chunk is the position of the chunk among the collection's chunks.
+	Chunk int
+	// Start is the index of the first item in the chunk.
+	Start int
+	// Count is the number of items in the chunk.
+	Count int
+	Sum   []byte
+}
+
+// ChunkChecksums breaks the collection into chunks of the given size, in the
+// same way as ChunkCollect, and hashes each chunk using a new hash.Hash from h.
+// Items are hashed using their encoding from codec. When a collection is sent
+// or stored chunk by chunk, the checksums can be compared with VerifyChunks to
+// find which chunks were corrupted, so that only those need to be sent again.
+// An error is returned if per is less than 1.
+func (c Collection[T]) ChunkChecksums(per int, codec Codec[T], h func() hash.Hash) ([]Checksum, error) {
+	if per < 1 {
+		return nil, fmt.Errorf("collection: chunk size must be at least 1, got %d", per)
+	}
+
+	chunks := c.ChunkCollect(per)
+	sums := make([]Checksum, len(chunks))
+
+	for i, chunk := range chunks {
+		sum, err := chunk.checksum(codec, h)
+		if err != nil {
+			return nil, err
+		}
+
+		sums[i] = Checksum{Chunk: i, Start: i * per, Count: chunk.Count(), Sum: sum}
+	}
+
+	return sums, nil
+}
+
+// VerifyChunks compares the collection with checksums previously returned by
+// ChunkChecksums, and returns the position of each chunk that doesn't match,
+// including any chunk with missing items. Items after the end of the last
+// checksum are not checked.
+func (c Collection[T]) VerifyChunks(sums []Checksum, codec Codec[T], h func() hash.Hash) ([]int, error) {
+	bad := []int{}
+
+	for _, want := range sums {
+		chunk := c.SubSlice(want.Start, want.Start+want.Count)
+		if chunk.Count() != want.Count {
+			bad = append(bad, want.Chunk)
+			continue
+		}
+
+		sum, err := chunk.checksum(codec, h)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(sum, want.Sum) {
+			bad = append(bad, want.Chunk)
+		}
+	}
+
+	return bad, nil
+}
+
+// checksum hashes the encoding of every item in the collection. Each item is
+// prefixed with its length, so that moving bytes between neighbouring items
+// changes the checksum.
+func (c Collection[T]) checksum(codec Codec[T], h func() hash.Hash) ([]byte, error) {
+	hasher := h()
+	buf := make([]byte, binary.MaxVarintLen64)
+
+	for _, v := range c.contents {
+		data, err := codec.Encode(v)
+		if err != nil {
+			return nil, err
+		}
+
+		hasher.Write(buf[:binary.PutUvarint(buf, uint64(len(data)))])
+		hasher.Write(data)
+	}
+
+	return hasher.Sum(nil), nil
+}
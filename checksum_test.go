@@ -0,0 +1,38 @@
+package collection_test
+
+import (
+	"crypto/sha256"
+	"math"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkChecksums(t *testing.T) {
+	col := collection.From([]string{"a", "b", "c", "d", "e"})
+	codec := collection.JSONCodec[string]{}
+
+	sums, err := col.ChunkChecksums(2, codec, sha256.New)
+	assert.NoError(t, err)
+	assert.Len(t, sums, 3)
+	assert.Equal(t, 4, sums[2].Start)
+	assert.Equal(t, 1, sums[2].Count)
+
+	bad, err := col.VerifyChunks(sums, codec, sha256.New)
+	assert.NoError(t, err)
+	assert.Empty(t, bad)
+
+	received := collection.From([]string{"a", "b", "x", "d"})
+	bad, err = received.VerifyChunks(sums, codec, sha256.New)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, bad)
+
+	_, err = col.ChunkChecksums(0, codec, sha256.New)
+	assert.Error(t, err)
+
+	sums, err = col.ChunkChecksums(math.MaxInt, codec, sha256.New)
+	assert.NoError(t, err)
+	assert.Len(t, sums, 1)
+	assert.Equal(t, 5, sums[0].Count)
+}
@@ -0,0 +1,70 @@
+// Command collectiongen generates a specialised collection type for a struct.
+// It is intended to be run by go generate, for example:
+//
+//	//go:generate collectiongen -type User
+//
+// which reads the file containing the directive and writes the generated
+// UserCollection to user_collection.go alongside it.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/gostalt/collection/gen"
+)
+
+func main() {
+	typeName := flag.String("type", "", "name of the struct to generate a collection for")
+	in := flag.String("in", os.Getenv("GOFILE"), "file containing the struct")
+	out := flag.String("out", "", "file to write the collection to (default <type>_collection.go)")
+	flag.Parse()
+
+	if *typeName == "" || *in == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *out == "" {
+		*out = filepath.Join(filepath.Dir(*in), snake(*typeName)+"_collection.go")
+	}
+
+	if err := run(*typeName, *in, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "collectiongen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(typeName string, in string, out string) error {
+	src, err := os.ReadFile(in)
+	if err != nil {
+		return err
+	}
+
+	code, err := gen.Generate(src, typeName)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(out, code, 0o644)
+}
+
+// snake converts a name such as UserAccount into user_account.
+func snake(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
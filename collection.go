@@ -4,29 +4,61 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"math/rand"
+	"sync/atomic"
 
 	"github.com/gostalt/collection/join"
 )
 
 type Collection[T comparable] struct {
 	contents []T
+	bindings []func(values []T)
+	derived  map[string]Collection[T]
+	mods     *atomic.Uint64
+	parallel *parallelConfig
+	interner *Interner
+	bounds   boundsMode
+
+	// tail records the length of the longest collection that has been written
+	// to the underlying array. It is shared by every collection using the same
+	// underlying array, so that Append only writes into the spare capacity of
+	// the array if no other collection has already claimed it.
+	tail *int
 }
 
 // Make returns a new empty collection of type T.
 func Make[T comparable]() Collection[T] {
-	return Collection[T]{
-		contents: []T{},
-	}
+	return From([]T{})
+}
+
+// MakeWithCapacity returns a new empty collection of type T, with enough
+// capacity to hold n items before its underlying slice needs to grow.
+func MakeWithCapacity[T comparable](n int) Collection[T] {
+	return From(make([]T, 0, n))
 }
 
 // From returns a new collection from the provided slice.
+//
+// The collection takes ownership of any spare capacity in the slice, and Append
+// will write into it.
 func From[T comparable](slice []T) Collection[T] {
+	tail := len(slice)
+
 	return Collection[T]{
 		contents: slice,
+		tail:     &tail,
 	}
 }
 
+// FromCopy returns a new collection from a copy of the provided slice. Unlike
+// From, changes made through the collection never affect the original slice,
+// and changes to the original slice never affect the collection.
+func FromCopy[T comparable](slice []T) Collection[T] {
+	contents := make([]T, len(slice))
+	copy(contents, slice)
+
+	return From(contents)
+}
+
 // All returns the underlying data for the collection.
 func (c Collection[T]) All() []T {
 	return c.contents
@@ -39,18 +71,70 @@ func (c Collection[T]) Slice() []T {
 	return c.All()
 }
 
+// Clone returns a copy of the collection with its own underlying slice, so that
+// changes made to either collection do not affect the other. Items are copied
+// by value; use CloneFunc to deep copy items such as pointers.
+func (c Collection[T]) Clone() Collection[T] {
+	return FromCopy(c.All())
+}
+
+// CloneFunc returns a copy of the collection with its own underlying slice,
+// using the given func to copy each item.
+func CloneFunc[T comparable](c Collection[T], clone func(v T) T) Collection[T] {
+	new := From(make([]T, c.Count()))
+	for i, v := range c.All() {
+		new.contents[i] = clone(v)
+	}
+
+	return new
+}
+
 // Filter uses the provided predicate to filter the collection, keeping only the
 // items for which the predicate returns true.
 func (c Collection[T]) Filter(predicate func(i int, v T) bool) Collection[T] {
-	new := Make[T]()
+	// Unlike Map, the size of the result isn't known in advance, so let append
+	// grow it, rather than leave a selective filter holding a full-size array.
+	contents := []T{}
+
+	if c.parallel != nil {
+		keep := make([]bool, c.Count())
+		kept := 0
+		c.runAdaptive(func(i int) {
+			keep[i] = predicate(i, c.contents[i])
+		})
+		for _, k := range keep {
+			if k {
+				kept++
+			}
+		}
+
+		contents = make([]T, 0, kept)
+		for i, v := range c.contents {
+			if keep[i] {
+				contents = append(contents, v)
+			}
+		}
+
+		new := From(contents)
+		new.parallel = c.parallel
+		return new
+	}
 
 	for i, v := range c.All() {
 		if predicate(i, v) {
-			new.contents = append(new.All(), v)
+			contents = append(contents, v)
 		}
 	}
 
-	return new
+	return From(contents)
+}
+
+// Reject works in the opposite way to Filter, keeping only the items for which
+// the predicate returns false.
+func (c Collection[T]) Reject(predicate func(i int, v T) bool) Collection[T] {
+	return c.Filter(func(i int, v T) bool {
+		return !predicate(i, v)
+	})
 }
 
 // First returns the first item in the collection. If the collection is empty, a
@@ -60,8 +144,8 @@ func (c Collection[T]) First() T {
 	return v
 }
 
-// SafeFirst works in the same way as First, but returns a collection.ErrNoItem
-// if no item was found in the collection (i.e., the collection was empty).
+// SafeFirst works in the same way as First, but returns an *IndexError wrapping
+// collection.ErrNoItem if the collection is empty.
 func (c Collection[T]) SafeFirst() (T, error) {
 	return c.SafeAt(0)
 }
@@ -73,33 +157,74 @@ func (c Collection[T]) Last() T {
 	return v
 }
 
-// SafeLast works in the same was as Last, but returns a collection.ErrNoItem if
-// no item was found in the collection (i.e., the collection was empty).
+// SafeLast works in the same way as Last, but returns an *IndexError wrapping
+// collection.ErrNoItem if the collection is empty.
 func (c Collection[T]) SafeLast() (T, error) {
-	if c.Empty() {
-		return *new(T), ErrNoItem
+	return c.SafeAt(c.Count() - 1)
+}
+
+// MustFirst returns the first item in the collection. Unlike First, it panics
+// with an *IndexError wrapping collection.ErrNoItem if the collection is empty,
+// for use where an empty collection can only be caused by a bug.
+func (c Collection[T]) MustFirst() T {
+	v, err := c.SafeFirst()
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// MustLast returns the last item in the collection. Unlike Last, it panics
+// with an *IndexError wrapping collection.ErrNoItem if the collection is empty.
+func (c Collection[T]) MustLast() T {
+	v, err := c.SafeLast()
+	if err != nil {
+		panic(err)
 	}
 
-	return c.All()[len(c.All())-1], nil
+	return v
 }
 
 // FirstWhere returns the first item from the collection that matches the provided
-// predicate.
+// predicate. If no item matches, a zero value is returned.
 func (c Collection[T]) FirstWhere(predicate func(i int, value T) bool) T {
+	v, _ := c.SafeFirstWhere(predicate)
+	return v
+}
+
+// SafeFirstWhere returns the first item from the collection that matches the
+// provided predicate. If no item matches, a zero value and collection.ErrNoItem
+// are returned.
+func (c Collection[T]) SafeFirstWhere(predicate func(i int, value T) bool) (T, error) {
 	for i, v := range c.All() {
 		if predicate(i, v) {
-			return v
+			return v, nil
 		}
 	}
 
-	return *new(T)
+	return *new(T), ErrNoItem
 }
 
-// SafeFirstWhere?
+// LastWhere returns the last item from the collection that matches the provided
+// predicate. If no item matches, a zero value is returned.
+func (c Collection[T]) LastWhere(predicate func(i int, value T) bool) T {
+	v, _ := c.SafeLastWhere(predicate)
+	return v
+}
 
-// LastWhere?
+// SafeLastWhere returns the last item from the collection that matches the
+// provided predicate. If no item matches, a zero value and collection.ErrNoItem
+// are returned.
+func (c Collection[T]) SafeLastWhere(predicate func(i int, value T) bool) (T, error) {
+	for i := len(c.contents) - 1; i >= 0; i-- {
+		if predicate(i, c.contents[i]) {
+			return c.contents[i], nil
+		}
+	}
 
-// SafeLastWhere?
+	return *new(T), ErrNoItem
+}
 
 // Has returns true if the collection contains any item that matches the provided
 // predicate. If no nothing matches, or collection is empty, false is returned.
@@ -131,6 +256,34 @@ func (c Collection[T]) Count() int {
 	return len(c.All())
 }
 
+// Cap returns the capacity of the collection's underlying slice.
+func (c Collection[T]) Cap() int {
+	return cap(c.contents)
+}
+
+// Grow increases the capacity of the collection's underlying slice, if needed,
+// to guarantee space for another n items. If n is negative, Grow panics.
+func (c *Collection[T]) Grow(n int) {
+	if n < 0 {
+		panic("collection: cannot grow by a negative number of items")
+	}
+
+	if cap(c.contents)-len(c.contents) >= n {
+		return
+	}
+
+	grown := make([]T, len(c.contents), len(c.contents)+n)
+	copy(grown, c.contents)
+	c.own(grown)
+	c.sync()
+}
+
+// Clip removes unused capacity from the collection's underlying slice.
+func (c *Collection[T]) Clip() {
+	c.contents = c.contents[:len(c.contents):len(c.contents)]
+	c.sync()
+}
+
 // CountWhere returns the number of items in the collection that match the given
 // predicate.
 func (c Collection[T]) CountWhere(predicate func(i int, value T) bool) int {
@@ -146,45 +299,114 @@ func (c Collection[T]) CountWhere(predicate func(i int, value T) bool) int {
 }
 
 // Append adds the given values to the end of the collection.
+//
+// Append only writes into the spare capacity of the underlying slice if no other
+// collection sharing it has already done so; otherwise the values are appended
+// to a copy. This means appending to two collections derived from the same
+// source never overwrites either collection's items.
+//
+// The returned collection is a new collection, so it does not keep any of the
+// original collection's bindings, derived collections or fail-fast mode.
 func (c Collection[T]) Append(value ...T) Collection[T] {
-	c.contents = append(c.All(), value...)
+	value = c.internValues(value)
+
+	owned := c.tail != nil && *c.tail == len(c.contents)
+	if owned && cap(c.contents)-len(c.contents) >= len(value) {
+		c.contents = append(c.contents, value...)
+		*c.tail = len(c.contents)
+	} else {
+		c.own(append(c.contents[:len(c.contents):len(c.contents)], value...))
+	}
+
+	c.bindings = nil
+	c.derived = nil
+	c.mods = nil
 
 	return c
 }
 
+// own replaces the collection's underlying slice with a newly allocated one,
+// which the collection doesn't share with any other.
+func (c *Collection[T]) own(contents []T) {
+	tail := len(contents)
+	c.contents = contents
+	c.tail = &tail
+}
+
 // Prepend adds the given values to the start of the collection.
 func (c Collection[T]) Prepend(value ...T) Collection[T] {
 	return From(value).Append(c.All()...)
 }
 
 // At returns the item at the given index. If the index does not exist in the
-// collection, a zero value is returned.
+// collection, a zero value is returned, unless the collection was created by New
+// with the WithStrictBounds or WithClamping option.
 func (c Collection[T]) At(i int) T {
+	i = c.bound(i, c.Count()-1)
+
 	v, _ := c.SafeAt(i)
 	return v
 }
 
+// MustAt returns the item at the given index. Unlike At, it panics with an
+// *IndexError wrapping collection.ErrIndexOutOfRange if the index does not
+// exist in the collection.
+func (c Collection[T]) MustAt(i int) T {
+	if i < 0 || i >= c.Count() {
+		panic(&IndexError{Index: i, Len: c.Count(), Err: ErrIndexOutOfRange})
+	}
+
+	return c.contents[i]
+}
+
 // SafeAt returns the item at the given index. If the index does not exist in the
-// collection, a zero value is returned along with collection.ErrNoItem.
+// collection, a zero value is returned along with an *IndexError wrapping
+// collection.ErrNoItem.
 func (c Collection[T]) SafeAt(i int) (T, error) {
-	if c.Empty() || c.Count() < i {
-		return *new(T), ErrNoItem
+	if i < 0 || i >= c.Count() {
+		return *new(T), &IndexError{Index: i, Len: c.Count(), Err: ErrNoItem}
 	}
 
 	return c.All()[i], nil
 }
 
 // Chan returns a readonly channel for consuming values from the collection.
+// The channel is closed once every value has been sent. In fail-fast mode, the
+// channel is closed early if the collection is modified; use ChanErr to find out
+// whether that happened.
 func (c Collection[T]) Chan() <-chan T {
+	ch, _ := c.ChanErr()
+	return ch
+}
+
+// ChanErr returns a readonly channel for consuming values from the collection,
+// along with a func reporting why the channel was closed. The func blocks until
+// the channel is closed, then returns nil if every value was sent, or
+// collection.ErrConcurrentModification if the collection was modified in
+// fail-fast mode.
+func (c Collection[T]) ChanErr() (<-chan T, func() error) {
 	ch := make(chan T)
+	done := make(chan struct{})
+	var err error
 
 	go func(ch chan<- T, c Collection[T]) {
+		defer close(done)
+		defer close(ch)
+
+		start := c.modCount()
 		for i := 0; i < c.Count(); i++ {
+			if c.modified(start) {
+				err = ErrConcurrentModification
+				return
+			}
 			ch <- c.At(i)
 		}
 	}(ch, c)
 
-	return ch
+	return ch, func() error {
+		<-done
+		return err
+	}
 }
 
 // Concat appends the given collection's values to the end of the existing
@@ -195,10 +417,7 @@ func (c Collection[T]) Concat(val Collection[T]) Collection[T] {
 
 // Chunk breaks the collection into smaller slices of a given size.
 //
-// Limitations with generics means it is not possible to return a collection of
-// collection (i.e., collection[collection[T]]). If you wish to continue working
-// with collections with the returned chunks, you'll need to use From to turn
-// them back into collections.
+// Use ChunkCollect to continue working with each chunk as a collection.
 func (c Collection[T]) Chunk(per int) [][]T {
 	count := int(math.Ceil(float64(c.Count()) / float64(per)))
 	chunks := make([][]T, count)
@@ -217,6 +436,184 @@ func (c Collection[T]) Chunk(per int) [][]T {
 	return chunks
 }
 
+// ChunkWhile breaks the collection into chunks of neighbouring items, starting a
+// new chunk whenever the given func returns false for an item and the item
+// before it. For example, it can be used to group runs of consecutive numbers.
+func (c Collection[T]) ChunkWhile(fn func(prev T, next T) bool) []Collection[T] {
+	chunks := []Collection[T]{}
+	start := 0
+
+	for i := 1; i <= c.Count(); i++ {
+		if i == c.Count() || !fn(c.At(i-1), c.At(i)) {
+			chunks = append(chunks, c.SubSlice(start, i))
+			start = i
+		}
+	}
+
+	return chunks
+}
+
+// SplitWhere splits the collection into chunks separated by the items that match
+// the given predicate, which are not included in any chunk. In the same way as
+// strings.Split, neighbouring separators produce an empty chunk between them,
+// and a collection with n separators always produces n+1 chunks.
+func (c Collection[T]) SplitWhere(predicate func(i int, value T) bool) []Collection[T] {
+	chunks := []Collection[T]{}
+	start := 0
+
+	for i, v := range c.All() {
+		if predicate(i, v) {
+			chunks = append(chunks, c.SubSlice(start, i))
+			start = i + 1
+		}
+	}
+
+	return append(chunks, c.SubSlice(start, c.Count()))
+}
+
+// StablePartitionWithIndex splits the collection into the items that match the
+// predicate and the rest, keeping the items of each in their original order.
+// It also returns the original index of every item, as if rest were appended
+// to matched: origIdx[i] is the index of matched.At(i) for i less than
+// matched.Count(), and the index of rest.At(i-matched.Count()) after that. This
+// allows results computed from either collection to be written back to the
+// positions the items came from.
+func (c Collection[T]) StablePartitionWithIndex(predicate func(i int, value T) bool) (matched Collection[T], rest Collection[T], origIdx []int) {
+	in := make([]T, 0, c.Count())
+	out := make([]T, 0, c.Count())
+	inIdx := make([]int, 0, c.Count())
+	outIdx := make([]int, 0, c.Count())
+
+	for i, v := range c.contents {
+		if predicate(i, v) {
+			in = append(in, v)
+			inIdx = append(inIdx, i)
+		} else {
+			out = append(out, v)
+			outIdx = append(outIdx, i)
+		}
+	}
+
+	return From(in), From(out), append(inIdx, outIdx...)
+}
+
+// EveryNth returns every n-th item from the collection, starting with the item at
+// the given offset. For example, EveryNth(2, 1) returns the items at indexes 1,
+// 3, 5 and so on. If n is less than one, an empty collection is returned.
+func (c Collection[T]) EveryNth(n int, offset int) Collection[T] {
+	if n < 1 {
+		return Make[T]()
+	}
+
+	offset = clamp(offset, 0, c.Count())
+	if offset == c.Count() {
+		return Make[T]()
+	}
+
+	// Written so that neither the capacity nor the index can overflow when n
+	// is close to math.MaxInt.
+	contents := make([]T, 0, (c.Count()-offset-1)/n+1)
+	for i := offset; ; i += n {
+		contents = append(contents, c.At(i))
+		if n >= c.Count()-i {
+			break
+		}
+	}
+
+	return From(contents)
+}
+
+// Windows returns the collection's sliding windows of the given size, with the
+// start of each window step items after the start of the previous one. For
+// example, Windows(3, 1) of [1, 2, 3, 4] returns [1, 2, 3] and [2, 3, 4].
+// Windows that would run past the end of the collection are not returned, and a
+// size or step of less than one returns no windows.
+func (c Collection[T]) Windows(size int, step int) []Collection[T] {
+	if size < 1 || step < 1 || size > c.Count() {
+		return []Collection[T]{}
+	}
+
+	windows := make([]Collection[T], 0, (c.Count()-size)/step+1)
+	for start := 0; start+size <= c.Count(); start += step {
+		windows = append(windows, c.SubSlice(start, start+size))
+	}
+
+	return windows
+}
+
+// ChunkCollect breaks the collection into smaller collections of a given size.
+// The final chunk holds the remaining items, so may be smaller.
+func (c Collection[T]) ChunkCollect(per int) []Collection[T] {
+	if per < 1 || c.Empty() {
+		return []Collection[T]{}
+	}
+
+	// Compare per against the items left rather than adding it to start, so
+	// that a per close to math.MaxInt can't overflow.
+	chunks := make([]Collection[T], 0, (c.Count()-1)/per+1)
+	for start := 0; start < c.Count(); {
+		end := c.Count()
+		if per < end-start {
+			end = start + per
+		}
+
+		chunks = append(chunks, c.SubSlice(start, end))
+		start = end
+	}
+
+	return chunks
+}
+
+// PowerSet returns every subset of the collection, starting with the empty
+// subset and ending with the whole collection. Items keep their original order
+// within each subset. A collection of n items has 2^n subsets, so for anything
+// but small collections EachSubset should be used to visit them one at a time.
+func (c Collection[T]) PowerSet() []Collection[T] {
+	subsets := make([]Collection[T], 0, 1<<clamp(c.Count(), 0, 16))
+	c.EachSubset(func(subset Collection[T]) bool {
+		subsets = append(subsets, subset)
+		return true
+	})
+
+	return subsets
+}
+
+// EachSubset calls fn with each subset of the collection, in the same order as
+// PowerSet, building each subset only when it is needed. Iteration stops early
+// if fn returns false.
+func (c Collection[T]) EachSubset(fn func(subset Collection[T]) bool) {
+	// included works as a binary counter, with the first item as the lowest
+	// bit, so counting up from zero visits every combination of items.
+	included := make([]bool, c.Count())
+	size := 0
+
+	for {
+		subset := make([]T, 0, size)
+		for i, in := range included {
+			if in {
+				subset = append(subset, c.contents[i])
+			}
+		}
+
+		if !fn(From(subset)) {
+			return
+		}
+
+		i := 0
+		for ; i < len(included) && included[i]; i++ {
+			included[i] = false
+			size--
+		}
+
+		if i == len(included) {
+			return
+		}
+
+		included[i] = true
+		size++
+	}
+}
+
 // Unique returns all the unique items from the collection.
 func (c Collection[T]) Unique() Collection[T] {
 	new := Make[T]()
@@ -225,41 +622,228 @@ func (c Collection[T]) Unique() Collection[T] {
 		if new.HasNo(func(i int, value T) bool {
 			return value == v
 		}) {
-			new.contents = append(new.contents, v)
+			new = new.Append(v)
 		}
 	}
 
 	return new
 }
 
+// Compact returns a new collection with every zero value removed, such as empty
+// strings, zeros and nil pointers.
+func (c Collection[T]) Compact() Collection[T] {
+	var zero T
+
+	return c.CompactFunc(func(v T) bool {
+		return v == zero
+	})
+}
+
+// CompactFunc returns a new collection with every item for which isEmpty returns
+// true removed.
+func (c Collection[T]) CompactFunc(isEmpty func(v T) bool) Collection[T] {
+	return c.Filter(func(i int, v T) bool {
+		return !isEmpty(v)
+	})
+}
+
 // Map iterates through each item of the collection and uses the given function
-// to transform the item.
+// to transform the item. To drop items while transforming them, use FilterMap.
 func (c Collection[T]) Map(fn func(i int, value T) T) Collection[T] {
-	new := Make[T]()
+	new := From(make([]T, c.Count()))
+
+	if c.parallel != nil {
+		c.runAdaptive(func(i int) {
+			new.contents[i] = fn(i, c.contents[i])
+		})
+
+		new.parallel = c.parallel
+		return new
+	}
 
 	for i, v := range c.contents {
-		new = new.Append(fn(i, v))
+		new.contents[i] = fn(i, v)
 	}
 
 	return new
 }
 
+// MapInto works in the same way as Map, but writes the transformed items into
+// dst, returning the result as a slice. dst is overwritten from the start, and is
+// only reallocated if it doesn't have the capacity to hold every item, so a
+// buffer can be reused between calls to avoid allocations.
+func (c Collection[T]) MapInto(dst []T, fn func(i int, value T) T) []T {
+	return MapInto(c, dst, fn)
+}
+
+// MapInto uses fn to transform each item in the collection into another type,
+// writing the results into dst and returning them as a slice. dst is overwritten
+// from the start, and is only reallocated if it doesn't have the capacity to
+// hold every item.
+func MapInto[T comparable, U any](c Collection[T], dst []U, fn func(i int, value T) U) []U {
+	if cap(dst) < c.Count() {
+		dst = make([]U, c.Count())
+	}
+
+	dst = dst[:c.Count()]
+	for i, v := range c.All() {
+		dst[i] = fn(i, v)
+	}
+
+	return dst
+}
+
+// FilterMap transforms and filters the collection in a single pass. fn returns
+// the transformed item and whether it should be kept, so items can be dropped
+// without needing a sentinel value to filter out afterwards.
+func FilterMap[T comparable, U comparable](c Collection[T], fn func(i int, value T) (U, bool)) Collection[U] {
+	new := make([]U, 0, c.Count())
+
+	for i, v := range c.contents {
+		if u, ok := fn(i, v); ok {
+			new = append(new, u)
+		}
+	}
+
+	return From(new)
+}
+
 // Pop removes and returns items from the end of the collection.
 func (c *Collection[T]) Pop(count int) Collection[T] {
 	split := c.Split(c.Count() - count)
 	c.contents = c.All()[:c.Count()-count]
+	c.sync()
 
 	return split[1]
 }
 
+// RemoveAt returns a new collection with the item at the given index removed. If
+// the index does not exist in the collection, the collection is returned
+// unchanged.
+func (c Collection[T]) RemoveAt(i int) Collection[T] {
+	new, err := c.SafeRemoveAt(i)
+	if err != nil {
+		return c
+	}
+
+	return new
+}
+
+// SafeRemoveAt returns a new collection with the item at the given index
+// removed. If the index does not exist in the collection, the collection is
+// returned unchanged along with an *IndexError wrapping
+// collection.ErrIndexOutOfRange.
+func (c Collection[T]) SafeRemoveAt(i int) (Collection[T], error) {
+	if i < 0 || i >= c.Count() {
+		return c, &IndexError{Index: i, Len: c.Count(), Err: ErrIndexOutOfRange}
+	}
+
+	contents := make([]T, 0, c.Count()-1)
+	contents = append(contents, c.All()[:i]...)
+	contents = append(contents, c.All()[i+1:]...)
+
+	return From(contents), nil
+}
+
+// RemoveWhere returns a new collection with every item that matches the given
+// predicate removed, along with the number of items that were removed.
+func (c Collection[T]) RemoveWhere(predicate func(i int, value T) bool) (Collection[T], int) {
+	new := c.Filter(func(i int, v T) bool {
+		return !predicate(i, v)
+	})
+
+	return new, c.Count() - new.Count()
+}
+
+// Splice removes deleteCount items from the collection, starting at the given
+// index, and inserts the replacements in their place. The removed items are
+// returned as a new collection.
+//
+// A negative start counts back from the end of the collection. Both start and
+// deleteCount are clamped to the bounds of the collection.
+func (c *Collection[T]) Splice(start int, deleteCount int, replacements ...T) Collection[T] {
+	if start < 0 {
+		start = c.Count() + start
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start > c.Count() {
+		start = c.Count()
+	}
+
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+	if deleteCount > c.Count()-start {
+		deleteCount = c.Count() - start
+	}
+
+	removed := FromCopy(c.All()[start : start+deleteCount])
+
+	contents := make([]T, 0, c.Count()-deleteCount+len(replacements))
+	contents = append(contents, c.All()[:start]...)
+	contents = append(contents, replacements...)
+	contents = append(contents, c.All()[start+deleteCount:]...)
+	c.own(contents)
+	c.sync()
+
+	return removed
+}
+
 // Before returns the items before the provided index.
 func (c Collection[T]) Before(i int) Collection[T] {
-	return From(c.All()[:i])
+	i = c.bound(i, c.Count())
+	return From(c.All()[:i:i])
 }
 
 // After returns the items after the provided index.
 func (c Collection[T]) After(i int) Collection[T] {
-	return From(c.All()[i:])
+	i = c.bound(i, c.Count())
+	return From(c.All()[i:c.Count():c.Count()])
+}
+
+// SubSlice returns the items from start up to, but not including, end as a new
+// collection. Both indexes are clamped to the bounds of the collection, and if
+// start is not before end, an empty collection is returned.
+func (c Collection[T]) SubSlice(start int, end int) Collection[T] {
+	start = clamp(start, 0, c.Count())
+	end = clamp(end, 0, c.Count())
+
+	if start >= end {
+		return Make[T]()
+	}
+
+	return From(c.All()[start:end:end])
+}
+
+// SafeSubSlice returns the items from start up to, but not including, end as a
+// new collection. If either index is out of bounds, or start is after end, an
+// empty collection is returned along with an *IndexError wrapping
+// collection.ErrIndexOutOfRange.
+func (c Collection[T]) SafeSubSlice(start int, end int) (Collection[T], error) {
+	if start < 0 || start > end {
+		return Make[T](), &IndexError{Index: start, Len: c.Count(), Err: ErrIndexOutOfRange}
+	}
+
+	if end > c.Count() {
+		return Make[T](), &IndexError{Index: end, Len: c.Count(), Err: ErrIndexOutOfRange}
+	}
+
+	return c.SubSlice(start, end), nil
+}
+
+// clamp restricts v to between min and max, inclusive.
+func clamp(v int, min int, max int) int {
+	if v < min {
+		return min
+	}
+
+	if v > max {
+		return max
+	}
+
+	return v
 }
 
 // Split returns two collections, split on the given index.
@@ -273,10 +857,14 @@ func (c Collection[T]) Split(i int) []Collection[T] {
 // Diff returns the values from the original collection that are not found in the
 // given collection.
 func (c Collection[T]) Diff(comp Collection[T]) Collection[T] {
+	lookup := make(map[T]struct{}, comp.Count())
+	for _, v := range comp.All() {
+		lookup[v] = struct{}{}
+	}
+
 	return c.Filter(func(i int, v T) bool {
-		return comp.HasNo(func(i int, value T) bool {
-			return value == v
-		})
+		_, found := lookup[v]
+		return !found
 	})
 }
 
@@ -317,7 +905,127 @@ func (c Collection[T]) FirstX(count int) Collection[T] {
 		return c
 	}
 
-	return From(c.All()[:count])
+	return From(c.All()[:count:count])
+}
+
+// LastX returns the last X items from the collection as a new collection. If the
+// collection has fewer than the requested number of items, the original
+// collection is returned.
+func (c Collection[T]) LastX(count int) Collection[T] {
+	if c.Count() <= count {
+		return c
+	}
+
+	if count <= 0 {
+		return Make[T]()
+	}
+
+	return c.After(c.Count() - count)
+}
+
+// Take returns the first n items from the collection as a new collection. If the
+// collection has fewer than n items, the original collection is returned, and if
+// n is zero or less, an empty collection is returned.
+//
+// An alias of `FirstX` that also accepts negative values.
+func (c Collection[T]) Take(n int) Collection[T] {
+	if n <= 0 {
+		return Make[T]()
+	}
+
+	return c.FirstX(n)
+}
+
+// Skip returns a new collection without the first n items. If the collection has
+// n items or fewer, an empty collection is returned.
+func (c Collection[T]) Skip(n int) Collection[T] {
+	if n <= 0 {
+		return c
+	}
+
+	if n >= c.Count() {
+		return Make[T]()
+	}
+
+	return c.After(n)
+}
+
+// TakeWhile returns the items from the start of the collection up to, but not
+// including, the first item that does not match the given predicate.
+func (c Collection[T]) TakeWhile(predicate func(i int, value T) bool) Collection[T] {
+	for i, v := range c.All() {
+		if !predicate(i, v) {
+			return c.Before(i)
+		}
+	}
+
+	return c
+}
+
+// TakeUntil returns the items from the start of the collection up to, but not
+// including, the first item that matches the given predicate.
+func (c Collection[T]) TakeUntil(predicate func(i int, value T) bool) Collection[T] {
+	return c.TakeWhile(func(i int, value T) bool {
+		return !predicate(i, value)
+	})
+}
+
+// SkipWhile returns the items from the first item that does not match the given
+// predicate to the end of the collection.
+func (c Collection[T]) SkipWhile(predicate func(i int, value T) bool) Collection[T] {
+	for i, v := range c.All() {
+		if !predicate(i, v) {
+			return c.After(i)
+		}
+	}
+
+	return Make[T]()
+}
+
+// Pad grows the collection to the given length by adding the given value to the
+// end of it. A negative length adds the value to the start instead. If the
+// collection is already long enough, it is returned unchanged.
+func (c Collection[T]) Pad(length int, value T) Collection[T] {
+	if length < 0 {
+		return c.PadLeft(-length, value)
+	}
+
+	return c.PadRight(length, value)
+}
+
+// PadLeft grows the collection to the given length by adding the given value to
+// the start of it. If the collection is already long enough, it is returned
+// unchanged.
+func (c Collection[T]) PadLeft(length int, value T) Collection[T] {
+	if c.Count() >= length {
+		return c
+	}
+
+	contents := make([]T, length)
+	padding := length - c.Count()
+	for i := 0; i < padding; i++ {
+		contents[i] = value
+	}
+	copy(contents[padding:], c.All())
+
+	return From(contents)
+}
+
+// PadRight grows the collection to the given length by adding the given value to
+// the end of it. If the collection is already long enough, it is returned
+// unchanged.
+func (c Collection[T]) PadRight(length int, value T) Collection[T] {
+	if c.Count() >= length {
+		return c
+	}
+
+	contents := make([]T, length)
+	copy(contents, c.All())
+	for i := c.Count(); i < length; i++ {
+		contents[i] = value
+	}
+
+	return From(contents)
 }
 
 // Empty returns true if the collection contains no items.
@@ -334,11 +1042,11 @@ func (c Collection[T]) NotEmpty() bool {
 	return !c.Empty()
 }
 
-// Random uses the provided *rand.Rand to pick the given number of items from the
-// collection. Elements can be picked more than once. Because random elements
-// are picked, the count parameter can be larger than the total size of
-// the collection.
-func (c Collection[T]) Random(r *rand.Rand, count int) Collection[T] {
+// Random uses the provided source, such as a *rand.Rand, to pick the given
+// number of items from the collection. Elements can be picked more than once.
+// Because random elements are picked, the count parameter can be larger than
+// the total size of the collection.
+func (c Collection[T]) Random(r RandSource, count int) Collection[T] {
 	new := From(make([]T, count))
 	for i := range new.All() {
 		new.Set(i, c.random(r))
@@ -347,18 +1055,51 @@ func (c Collection[T]) Random(r *rand.Rand, count int) Collection[T] {
 	return new
 }
 
+// Shuffle uses the provided source, such as a *rand.Rand, to return a new
+// collection holding the collection's items in a random order. Unlike Random,
+// each item appears exactly once.
+func (c Collection[T]) Shuffle(r RandSource) Collection[T] {
+	return c.Sample(r, c.Count())
+}
+
+// Sample uses the provided source, such as a *rand.Rand, to pick n items from
+// the collection. Unlike Random, no item is picked more than once, so if n is
+// larger than the collection, every item is returned, in a random order.
+func (c Collection[T]) Sample(r RandSource, n int) Collection[T] {
+	new := c.Clone()
+	n = clamp(n, 0, new.Count())
+
+	// Run just the first n steps of a Fisher-Yates shuffle.
+	for i := 0; i < n; i++ {
+		j := i + r.Intn(new.Count()-i)
+		new.contents[i], new.contents[j] = new.contents[j], new.contents[i]
+	}
+
+	return new.FirstX(n)
+}
+
 // random returns a single item from the underlying contents of the collection.
-func (c Collection[T]) random(r *rand.Rand) T {
+func (c Collection[T]) random(r RandSource) T {
 	return c.At(r.Intn(c.Count()))
 }
 
 // Set updates the value at the given index to value. If the given index is out of
 // range for the collection's underlying slice, the slice is expanded to allow
-// the value to be set. Use `SafeSet` to prevent this behaviour and return
-// an error if out of bounds.
+// the value to be set. A negative index can't be grown into, so Set panics
+// with an *IndexError wrapping collection.ErrIndexOutOfRange. Use `SafeSet` to
+// prevent this behaviour and return an error if out of bounds, or create the
+// collection with New and the WithStrictBounds or WithClamping option.
 func (c *Collection[T]) Set(index int, value T) {
-	if c.Count() >= index {
+	value = c.internValue(value)
+
+	index = c.bound(index, c.Count()-1)
+	if index < 0 {
+		panic(&IndexError{Index: index, Len: c.Count(), Err: ErrIndexOutOfRange})
+	}
+
+	if index < c.Count() {
 		c.contents[index] = value
+		c.sync()
 		return
 	}
 
@@ -368,18 +1109,20 @@ func (c *Collection[T]) Set(index int, value T) {
 	}
 
 	new[index] = value
-	c.contents = new
+	c.own(new)
+	c.sync()
 }
 
 // SafeSet updates the value at the given index to value. If the given index is
-// out of range for the collection's underlying slice, an error is returned and
-// the collection is not modified.
+// out of range for the collection's underlying slice, an *IndexError wrapping
+// collection.ErrIndexOutOfRange is returned and the collection is not modified.
 func (c *Collection[T]) SafeSet(index int, value T) error {
-	if c.Count() < index {
-		return ErrIndexOutOfRange
+	if index < 0 || index >= c.Count() {
+		return &IndexError{Index: index, Len: c.Count(), Err: ErrIndexOutOfRange}
 	}
 
-	c.contents[index] = value
+	c.contents[index] = c.internValue(value)
+	c.sync()
 
 	return nil
 }
@@ -387,20 +1130,49 @@ func (c *Collection[T]) SafeSet(index int, value T) error {
 // Each iterates over each item inside the collection and passes the index and value
 // to the provided func.
 func (c Collection[T]) Each(fn func(i int, value T)) {
+	start := c.modCount()
 	for i, v := range c.All() {
 		fn(i, v)
+		c.checkMods(start)
+	}
+}
+
+// EachReverse iterates over each item inside the collection, from the last item
+// to the first, and passes the index and value to the provided func.
+func (c Collection[T]) EachReverse(fn func(i int, value T)) {
+	start := c.modCount()
+	for i := c.Count() - 1; i >= 0; i-- {
+		fn(i, c.contents[i])
+		c.checkMods(start)
+	}
+}
+
+// EachStep iterates over every step-th item inside the collection, starting with
+// the first, and passes the index and value to the provided func. A step of less
+// than one iterates over nothing.
+func (c Collection[T]) EachStep(step int, fn func(i int, value T)) {
+	if step < 1 {
+		return
+	}
+
+	start := c.modCount()
+	for i := 0; i < c.Count(); i += step {
+		fn(i, c.contents[i])
+		c.checkMods(start)
 	}
 }
 
 // EachCtx iterates over each item inside the collection and passes the index and
 // value to the provided func. If the given context is Done, the iteration stops.
 func (c Collection[T]) EachCtx(ctx context.Context, fn func(i int, value T)) {
+	start := c.modCount()
 	for i, v := range c.All() {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 			fn(i, v)
+			c.checkMods(start)
 		}
 	}
 }
@@ -427,6 +1199,94 @@ func (c Collection[T]) Reverse() Collection[T] {
 	return new
 }
 
+// FilterInPlace works in the same way as Filter, but reuses the collection's
+// underlying array instead of allocating a new one. Any other collection sharing
+// the underlying array will see its contents change.
+func (c *Collection[T]) FilterInPlace(predicate func(i int, v T) bool) {
+	kept := c.contents[:0]
+
+	for i, v := range c.contents {
+		if predicate(i, v) {
+			kept = append(kept, v)
+		}
+	}
+
+	// Clear the now unused tail so that it doesn't keep values reachable.
+	var zero T
+	for i := len(kept); i < len(c.contents); i++ {
+		c.contents[i] = zero
+	}
+
+	if c.tail != nil && *c.tail == len(c.contents) {
+		*c.tail = len(kept)
+	}
+
+	c.contents = kept
+	c.sync()
+}
+
+// MapInPlace works in the same way as Map, but writes the transformed items back
+// into the collection's underlying array instead of allocating a new one.
+func (c *Collection[T]) MapInPlace(fn func(i int, value T) T) {
+	for i, v := range c.contents {
+		c.contents[i] = fn(i, v)
+	}
+
+	c.sync()
+}
+
+// ReverseInPlace works in the same way as Reverse, but reverses the order of the
+// items inside the collection's underlying array instead of allocating a new one.
+func (c *Collection[T]) ReverseInPlace() {
+	for i, j := 0, c.Count()-1; i < j; i, j = i+1, j-1 {
+		c.contents[i], c.contents[j] = c.contents[j], c.contents[i]
+	}
+
+	c.sync()
+}
+
+// Contains returns true if the collection holds an item equal to v.
+func (c Collection[T]) Contains(v T) bool {
+	return c.IndexOf(v) != -1
+}
+
+// IndexOf returns the index of the first item equal to v. If no item is found,
+// -1 is returned.
+func (c Collection[T]) IndexOf(v T) int {
+	for i, item := range c.contents {
+		if item == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastIndexOf returns the index of the last item equal to v. If no item is
+// found, -1 is returned.
+func (c Collection[T]) LastIndexOf(v T) int {
+	for i := len(c.contents) - 1; i >= 0; i-- {
+		if c.contents[i] == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IndicesWhere returns the index of every item that matches the given
+// predicate, in ascending order.
+func (c Collection[T]) IndicesWhere(predicate func(i int, value T) bool) []int {
+	indices := []int{}
+	for i, v := range c.contents {
+		if predicate(i, v) {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}
+
 // Search returns the index of the first item that matches the given predicate.
 // If no item is found, -1 is returned.
 func (c Collection[T]) Search(fn func(i int, value T) bool) int {
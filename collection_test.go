@@ -2,7 +2,10 @@ package collection_test
 
 import (
 	"context"
+	"math"
 	"math/rand"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gostalt/collection"
@@ -16,6 +19,44 @@ func TestCanAccessUnderlyingSlice(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3}, v.All())
 }
 
+func TestFromCopy(t *testing.T) {
+	orig := make([]int, 3, 10)
+	copy(orig, []int{1, 2, 3})
+
+	col := collection.FromCopy(orig)
+	col.Set(0, 5)
+	col = col.Append(4)
+	orig[1] = 6
+
+	assert.Equal(t, []int{5, 2, 3, 4}, col.All())
+	assert.Equal(t, []int{1, 6, 3}, orig)
+	assert.Equal(t, []int{1, 6, 3, 0}, orig[:4])
+}
+
+func TestClone(t *testing.T) {
+	orig := collection.From([]int{1, 2, 3, 4})
+	clone := orig.Clone()
+	orig.Set(0, 5)
+	orig.Pop(1)
+
+	assert.Equal(t, []int{5, 2, 3}, orig.All())
+	assert.Equal(t, []int{1, 2, 3, 4}, clone.All())
+}
+
+func TestCloneFunc(t *testing.T) {
+	one, two := 1, 2
+	orig := collection.From([]*int{&one, &two})
+	clone := collection.CloneFunc(orig, func(v *int) *int {
+		c := *v
+		return &c
+	})
+	one = 5
+
+	assert.Equal(t, 5, *orig.First())
+	assert.Equal(t, 1, *clone.First())
+	assert.Equal(t, 2, *clone.Last())
+}
+
 func TestFilter(t *testing.T) {
 	v := collection.
 		From([]int{1, 1, 2, 2}).
@@ -26,6 +67,25 @@ func TestFilter(t *testing.T) {
 	assert.Equal(t, []int{2, 2}, v.All())
 }
 
+func TestFilterDoesNotKeepFullSizeArray(t *testing.T) {
+	v := collection.FromRange(1, 10000).Filter(func(i int, value int) bool {
+		return value == 1
+	})
+
+	assert.Equal(t, []int{1}, v.All())
+	assert.Less(t, cap(v.All()), 100)
+}
+
+func TestReject(t *testing.T) {
+	v := collection.
+		From([]int{1, 1, 2, 2}).
+		Reject(func(i int, value int) bool {
+			return value == 2
+		})
+
+	assert.Equal(t, []int{1, 1}, v.All())
+}
+
 func TestFirst(t *testing.T) {
 	v := collection.From([]int{3, 2, 1}).First()
 	assert.Equal(t, 3, v)
@@ -54,6 +114,8 @@ func TestLast(t *testing.T) {
 func TestSafeLast(t *testing.T) {
 	_, err := collection.From([]string{}).SafeLast()
 	assert.ErrorIs(t, err, collection.ErrNoItem)
+	var indexErr *collection.IndexError
+	assert.ErrorAs(t, err, &indexErr)
 
 	v, err := collection.From([]string{"hello", "world"}).SafeLast()
 	assert.NoError(t, err)
@@ -76,6 +138,37 @@ func TestFirstWhereOfEmptyReturnsZeroValue(t *testing.T) {
 	assert.Equal(t, 0, v)
 }
 
+func TestSafeFirstWhere(t *testing.T) {
+	v, err := collection.From([]int{1, 4, 6}).SafeFirstWhere(func(i int, value int) bool {
+		return value%2 == 0
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, v)
+
+	_, err = collection.From([]int{1, 3}).SafeFirstWhere(func(i int, value int) bool {
+		return value%2 == 0
+	})
+
+	assert.ErrorIs(t, err, collection.ErrNoItem)
+}
+
+func TestLastWhere(t *testing.T) {
+	even := func(i int, value int) bool {
+		return value%2 == 0
+	}
+
+	assert.Equal(t, 6, collection.From([]int{1, 4, 6, 7}).LastWhere(even))
+	assert.Equal(t, 0, collection.From([]int{1, 3}).LastWhere(even))
+
+	v, err := collection.From([]int{4, 5}).SafeLastWhere(even)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, v)
+
+	_, err = collection.From([]int{}).SafeLastWhere(even)
+	assert.ErrorIs(t, err, collection.ErrNoItem)
+}
+
 func TestHas(t *testing.T) {
 	success := collection.From([]int{1, 3, 5}).Has(func(i int, value int) bool {
 		return value == 3
@@ -124,6 +217,37 @@ func TestCount(t *testing.T) {
 	assert.Equal(t, 1, count)
 }
 
+func TestMakeWithCapacity(t *testing.T) {
+	col := collection.MakeWithCapacity[int](10)
+
+	assert.Equal(t, 0, col.Count())
+	assert.Equal(t, 10, col.Cap())
+}
+
+func TestGrow(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+	col.Grow(5)
+
+	assert.Equal(t, []int{1, 2, 3}, col.All())
+	assert.GreaterOrEqual(t, col.Cap(), 8)
+
+	cap := col.Cap()
+	col.Grow(1)
+	assert.Equal(t, cap, col.Cap())
+
+	assert.Panics(t, func() {
+		col.Grow(-1)
+	})
+}
+
+func TestClip(t *testing.T) {
+	col := collection.MakeWithCapacity[int](10).Append(1, 2)
+	col.Clip()
+
+	assert.Equal(t, []int{1, 2}, col.All())
+	assert.Equal(t, 2, col.Cap())
+}
+
 func TestCountWhere(t *testing.T) {
 	count := collection.From([]int{1, 2, 3}).CountWhere(func(i int, value int) bool {
 		return value%2 == 1
@@ -146,6 +270,34 @@ func TestAppend(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3, 4, 5}, new.All())
 }
 
+func TestAppendNeverOverwritesSiblings(t *testing.T) {
+	source := collection.MakeWithCapacity[int](10).Append(1, 2, 3)
+
+	first := source.Append(4)
+	second := source.Append(5)
+	assert.Equal(t, []int{1, 2, 3, 4}, first.All())
+	assert.Equal(t, []int{1, 2, 3, 5}, second.All())
+
+	before := source.Before(1).Append(9)
+	assert.Equal(t, []int{1, 9}, before.All())
+	assert.Equal(t, []int{1, 2, 3}, source.All())
+
+	popped := source.Pop(1)
+	source = source.Append(8)
+	assert.Equal(t, []int{3}, popped.All())
+	assert.Equal(t, []int{1, 2, 8}, source.All())
+}
+
+func TestAppendReusesOwnedCapacity(t *testing.T) {
+	col := collection.MakeWithCapacity[int](3)
+	for i := 0; i < 3; i++ {
+		col = col.Append(i)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, col.All())
+	assert.Equal(t, 3, col.Cap())
+}
+
 func TestAt(t *testing.T) {
 	v := collection.From([]string{"first", "second", "third"})
 	assert.Equal(t, "first", v.At(0))
@@ -162,6 +314,38 @@ func TestSafeAt(t *testing.T) {
 	v, err = c.SafeAt(4)
 	assert.Equal(t, "", v)
 	assert.ErrorIs(t, err, collection.ErrNoItem)
+
+	var indexErr *collection.IndexError
+	assert.ErrorAs(t, err, &indexErr)
+	assert.Equal(t, 4, indexErr.Index)
+	assert.Equal(t, 3, indexErr.Len)
+	assert.EqualError(t, err, "item not found: index 4, length 3")
+}
+
+func TestMustAt(t *testing.T) {
+	c := collection.From([]string{"first", "second", "third"})
+	assert.Equal(t, "third", c.MustAt(2))
+
+	assert.PanicsWithError(t, "index out of range: index 3, length 3", func() {
+		c.MustAt(3)
+	})
+	assert.Panics(t, func() {
+		c.MustAt(-1)
+	})
+}
+
+func TestMustFirstAndMustLast(t *testing.T) {
+	c := collection.From([]int{1, 2, 3})
+	assert.Equal(t, 1, c.MustFirst())
+	assert.Equal(t, 3, c.MustLast())
+
+	empty := collection.Make[int]()
+	assert.PanicsWithError(t, "item not found: index 0, length 0", func() {
+		empty.MustFirst()
+	})
+	assert.PanicsWithError(t, "item not found: index -1, length 0", func() {
+		empty.MustLast()
+	})
 }
 
 func TestChan(t *testing.T) {
@@ -180,6 +364,20 @@ func TestChan(t *testing.T) {
 	assert.Equal(t, col.All(), vals)
 }
 
+func TestChanErr(t *testing.T) {
+	var vals []int
+
+	col := collection.From([]int{1, 2, 3, 4})
+	ch, errFn := col.ChanErr()
+
+	for v := range ch {
+		vals = append(vals, v)
+	}
+
+	assert.Equal(t, col.All(), vals)
+	assert.NoError(t, errFn())
+}
+
 func TestConcat(t *testing.T) {
 	first := collection.From([]int{1, 2, 3})
 	second := collection.From([]int{4, 5, 6})
@@ -198,12 +396,151 @@ func TestChunk(t *testing.T) {
 	assert.Equal(t, orig.All()[8:10], chunks[2])
 }
 
+func TestStablePartitionWithIndex(t *testing.T) {
+	col := collection.From([]int{5, 2, 7, 4, 1, 8})
+
+	evens, odds, idx := col.StablePartitionWithIndex(func(i int, value int) bool {
+		return value%2 == 0
+	})
+
+	assert.Equal(t, []int{2, 4, 8}, evens.All())
+	assert.Equal(t, []int{5, 7, 1}, odds.All())
+	assert.Equal(t, []int{1, 3, 5, 0, 2, 4}, idx)
+
+	for i, orig := range idx {
+		assert.Equal(t, col.At(orig), evens.Concat(odds).At(i))
+	}
+}
+
+func TestEveryNth(t *testing.T) {
+	col := collection.FromRange(0, 9)
+
+	assert.Equal(t, []int{0, 3, 6, 9}, col.EveryNth(3, 0).All())
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, col.EveryNth(2, 1).All())
+	assert.Equal(t, []int{9}, col.EveryNth(5, 9).All())
+	assert.True(t, col.EveryNth(2, 12).Empty())
+	assert.True(t, col.EveryNth(2, 100).Empty())
+	assert.True(t, col.EveryNth(0, 0).Empty())
+	assert.Equal(t, []int{0}, col.EveryNth(math.MaxInt, 0).All())
+	assert.Equal(t, []int{4}, col.EveryNth(math.MaxInt, 4).All())
+}
+
+func TestWindows(t *testing.T) {
+	windows := collection.FromRange(1, 5).Windows(3, 1)
+
+	assert.Len(t, windows, 3)
+	assert.Equal(t, []int{1, 2, 3}, windows[0].All())
+	assert.Equal(t, []int{2, 3, 4}, windows[1].All())
+	assert.Equal(t, []int{3, 4, 5}, windows[2].All())
+
+	stepped := collection.FromRange(1, 6).Windows(2, 3)
+	assert.Len(t, stepped, 2)
+	assert.Equal(t, []int{1, 2}, stepped[0].All())
+	assert.Equal(t, []int{4, 5}, stepped[1].All())
+
+	assert.Empty(t, collection.FromRange(1, 2).Windows(3, 1))
+	assert.Empty(t, collection.FromRange(1, 2).Windows(1, 0))
+}
+
+func TestChunkWhile(t *testing.T) {
+	chunks := collection.From([]int{1, 2, 3, 5, 6, 9}).ChunkWhile(func(prev int, next int) bool {
+		return next == prev+1
+	})
+
+	assert.Len(t, chunks, 3)
+	assert.Equal(t, []int{1, 2, 3}, chunks[0].All())
+	assert.Equal(t, []int{5, 6}, chunks[1].All())
+	assert.Equal(t, []int{9}, chunks[2].All())
+
+	assert.Empty(t, collection.Make[int]().ChunkWhile(func(prev int, next int) bool {
+		return true
+	}))
+}
+
+func TestSplitWhere(t *testing.T) {
+	blank := func(i int, value string) bool {
+		return value == ""
+	}
+
+	chunks := collection.From([]string{"a", "b", "", "c", "", "", "d"}).SplitWhere(blank)
+
+	assert.Len(t, chunks, 4)
+	assert.Equal(t, []string{"a", "b"}, chunks[0].All())
+	assert.Equal(t, []string{"c"}, chunks[1].All())
+	assert.True(t, chunks[2].Empty())
+	assert.Equal(t, []string{"d"}, chunks[3].All())
+
+	single := collection.From([]string{"a"}).SplitWhere(blank)
+	assert.Len(t, single, 1)
+	assert.Equal(t, []string{"a"}, single[0].All())
+}
+
+func TestPowerSet(t *testing.T) {
+	subsets := collection.From([]string{"a", "b", "c"}).PowerSet()
+
+	res := [][]string{}
+	for _, subset := range subsets {
+		res = append(res, subset.All())
+	}
+
+	assert.Equal(t, [][]string{
+		{}, {"a"}, {"b"}, {"a", "b"}, {"c"}, {"a", "c"}, {"b", "c"}, {"a", "b", "c"},
+	}, res)
+
+	assert.Len(t, collection.From([]int{}).PowerSet(), 1)
+}
+
+func TestEachSubset(t *testing.T) {
+	count := 0
+	collection.FromRange(1, 100).EachSubset(func(subset collection.Collection[int]) bool {
+		count++
+		return count < 5
+	})
+
+	assert.Equal(t, 5, count)
+}
+
+func TestChunkCollect(t *testing.T) {
+	chunks := collection.FromRange(1, 10).ChunkCollect(4)
+
+	assert.Len(t, chunks, 3)
+	assert.Equal(t, []int{1, 2, 3, 4}, chunks[0].All())
+	assert.Equal(t, []int{5, 6, 7, 8}, chunks[1].All())
+	assert.Equal(t, []int{9, 10}, chunks[2].All())
+	assert.Equal(t, []int{9, 10, 0}, chunks[2].Append(0).All())
+	assert.Equal(t, []int{5, 6, 7, 8}, chunks[1].All())
+
+	assert.Empty(t, collection.Make[int]().ChunkCollect(2))
+	assert.Empty(t, collection.FromRange(1, 3).ChunkCollect(0))
+
+	whole := collection.FromRange(1, 3).ChunkCollect(math.MaxInt)
+	assert.Len(t, whole, 1)
+	assert.Equal(t, []int{1, 2, 3}, whole[0].All())
+}
+
 func TestUnique(t *testing.T) {
 	orig := collection.From([]int{1, 2, 3, 1, 1, 2, 2, 3, 3}).Unique()
 
 	assert.Equal(t, []int{1, 2, 3}, orig.All())
 }
 
+func TestCompact(t *testing.T) {
+	strs := collection.From([]string{"a", "", "b", ""}).Compact()
+	assert.Equal(t, []string{"a", "b"}, strs.All())
+
+	one := 1
+	ptrs := collection.From([]*int{nil, &one, nil}).Compact()
+	assert.Equal(t, []*int{&one}, ptrs.All())
+}
+
+func TestCompactFunc(t *testing.T) {
+	col := collection.From([]string{"a", " ", "b", "\t"}).CompactFunc(func(v string) bool {
+		return strings.TrimSpace(v) == ""
+	})
+
+	assert.Equal(t, []string{"a", "b"}, col.All())
+}
+
 func TestMap(t *testing.T) {
 	doubled := collection.From([]int{1, 2, 3, 4, 5}).Map(func(i int, value int) int {
 		return value * 2
@@ -218,6 +555,42 @@ func TestMap(t *testing.T) {
 	assert.Equal(t, []string{"lions", "tigers", "bears"}, pluralised.All())
 }
 
+func TestMapInto(t *testing.T) {
+	buf := make([]int, 0, 8)
+	doubled := collection.From([]int{1, 2, 3}).MapInto(buf, func(i int, value int) int {
+		return value * 2
+	})
+
+	assert.Equal(t, []int{2, 4, 6}, doubled)
+	assert.Equal(t, []int{2, 4, 6}, buf[:3])
+
+	small := collection.From([]int{1, 2, 3}).MapInto(nil, func(i int, value int) int {
+		return value
+	})
+	assert.Equal(t, []int{1, 2, 3}, small)
+}
+
+func TestMapIntoOtherType(t *testing.T) {
+	buf := make([]string, 4)
+	strs := collection.MapInto(collection.From([]int{1, 2}), buf, func(i int, value int) string {
+		return strconv.Itoa(value)
+	})
+
+	assert.Equal(t, []string{"1", "2"}, strs)
+	assert.Equal(t, "1", buf[0])
+}
+
+func TestFilterMap(t *testing.T) {
+	col := collection.From([]string{"1", "two", "3", "", "5"})
+
+	res := collection.FilterMap(col, func(i int, value string) (int, bool) {
+		n, err := strconv.Atoi(value)
+		return n, err == nil
+	})
+
+	assert.Equal(t, []int{1, 3, 5}, res.All())
+}
+
 func TestPop(t *testing.T) {
 	orig := collection.From([]int{1, 2, 3, 4, 5})
 	single := orig.Pop(1)
@@ -231,6 +604,80 @@ func TestPop(t *testing.T) {
 	assert.Equal(t, []int{3, 4}, multi.All())
 }
 
+func TestRemoveAt(t *testing.T) {
+	orig := collection.From([]int{1, 2, 3})
+
+	assert.Equal(t, []int{1, 3}, orig.RemoveAt(1).All())
+	assert.Equal(t, []int{2, 3}, orig.RemoveAt(0).All())
+	assert.Equal(t, []int{1, 2, 3}, orig.RemoveAt(3).All())
+	assert.Equal(t, []int{1, 2, 3}, orig.All())
+}
+
+func TestSafeRemoveAt(t *testing.T) {
+	orig := collection.From([]int{1, 2, 3})
+
+	v, err := orig.SafeRemoveAt(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, v.All())
+
+	v, err = orig.SafeRemoveAt(-1)
+	assert.ErrorIs(t, err, collection.ErrIndexOutOfRange)
+	assert.Equal(t, []int{1, 2, 3}, v.All())
+}
+
+func TestRemoveWhere(t *testing.T) {
+	v, count := collection.From([]int{1, 2, 3, 4, 5}).RemoveWhere(func(i int, value int) bool {
+		return value%2 == 0
+	})
+
+	assert.Equal(t, []int{1, 3, 5}, v.All())
+	assert.Equal(t, 2, count)
+}
+
+func TestSplice(t *testing.T) {
+	col := collection.From([]int{1, 2, 3, 4, 5})
+	removed := col.Splice(1, 2)
+	assert.Equal(t, []int{1, 4, 5}, col.All())
+	assert.Equal(t, []int{2, 3}, removed.All())
+
+	removed = col.Splice(1, 1, 7, 8, 9)
+	assert.Equal(t, []int{1, 7, 8, 9, 5}, col.All())
+	assert.Equal(t, []int{4}, removed.All())
+
+	removed = col.Splice(-2, 10)
+	assert.Equal(t, []int{1, 7, 8}, col.All())
+	assert.Equal(t, []int{9, 5}, removed.All())
+
+	removed = col.Splice(10, 1, 0)
+	assert.Equal(t, []int{1, 7, 8, 0}, col.All())
+	assert.True(t, removed.Empty())
+}
+
+func TestSubSlice(t *testing.T) {
+	col := collection.From([]int{1, 2, 3, 4, 5})
+
+	assert.Equal(t, []int{2, 3}, col.SubSlice(1, 3).All())
+	assert.Equal(t, []int{1, 2}, col.SubSlice(-5, 2).All())
+	assert.Equal(t, []int{4, 5}, col.SubSlice(3, 10).All())
+	assert.True(t, col.SubSlice(4, 2).Empty())
+	assert.True(t, col.SubSlice(8, 10).Empty())
+}
+
+func TestSafeSubSlice(t *testing.T) {
+	col := collection.From([]int{1, 2, 3, 4, 5})
+
+	v, err := col.SafeSubSlice(0, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, v.All())
+
+	_, err = col.SafeSubSlice(3, 6)
+	assert.ErrorIs(t, err, collection.ErrIndexOutOfRange)
+	assert.EqualError(t, err, "index out of range: index 6, length 5")
+
+	_, err = col.SafeSubSlice(3, 2)
+	assert.ErrorIs(t, err, collection.ErrIndexOutOfRange)
+}
+
 func TestSplit(t *testing.T) {
 	values := collection.From([]int{1, 2, 3, 4, 5, 6}).Split(3)
 
@@ -265,6 +712,82 @@ func TestFirstX(t *testing.T) {
 	assert.Equal(t, collection.From([]int{1}).All(), one.All())
 }
 
+func TestPad(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+
+	assert.Equal(t, []int{1, 2, 3, 0, 0}, col.Pad(5, 0).All())
+	assert.Equal(t, []int{0, 0, 1, 2, 3}, col.Pad(-5, 0).All())
+	assert.Equal(t, []int{1, 2, 3}, col.Pad(2, 0).All())
+}
+
+func TestPadLeft(t *testing.T) {
+	col := collection.From([]string{"a"})
+
+	assert.Equal(t, []string{"-", "-", "a"}, col.PadLeft(3, "-").All())
+	assert.Equal(t, []string{"a"}, col.PadLeft(1, "-").All())
+}
+
+func TestPadRight(t *testing.T) {
+	col := collection.From([]float64{1.5})
+
+	assert.Equal(t, []float64{1.5, 0, 0, 0}, col.PadRight(4, 0).All())
+	assert.Equal(t, []float64{1.5}, col.PadRight(0, 0).All())
+}
+
+func TestLastX(t *testing.T) {
+	col := collection.From([]int{1, 2, 3, 4, 5})
+
+	assert.Equal(t, []int{4, 5}, col.LastX(2).All())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, col.LastX(10).All())
+	assert.True(t, col.LastX(0).Empty())
+}
+
+func TestTake(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+
+	assert.Equal(t, []int{1, 2}, col.Take(2).All())
+	assert.Equal(t, []int{1, 2, 3}, col.Take(5).All())
+	assert.True(t, col.Take(-1).Empty())
+}
+
+func TestSkip(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+
+	assert.Equal(t, []int{3}, col.Skip(2).All())
+	assert.Equal(t, []int{1, 2, 3}, col.Skip(0).All())
+	assert.True(t, col.Skip(3).Empty())
+	assert.True(t, col.Skip(10).Empty())
+}
+
+func TestTakeWhile(t *testing.T) {
+	col := collection.From([]int{1, 2, 3, 1})
+	small := func(i int, value int) bool {
+		return value < 3
+	}
+
+	assert.Equal(t, []int{1, 2}, col.TakeWhile(small).All())
+	assert.Equal(t, []int{1, 2}, collection.From([]int{1, 2}).TakeWhile(small).All())
+	assert.True(t, collection.From([]int{3, 1}).TakeWhile(small).Empty())
+}
+
+func TestTakeUntil(t *testing.T) {
+	col := collection.From([]string{"a", "b", "stop", "c"})
+
+	assert.Equal(t, []string{"a", "b"}, col.TakeUntil(func(i int, value string) bool {
+		return value == "stop"
+	}).All())
+}
+
+func TestSkipWhile(t *testing.T) {
+	col := collection.From([]int{1, 2, 3, 1})
+	small := func(i int, value int) bool {
+		return value < 3
+	}
+
+	assert.Equal(t, []int{3, 1}, col.SkipWhile(small).All())
+	assert.True(t, collection.From([]int{1, 2}).SkipWhile(small).Empty())
+}
+
 func TestEmpty(t *testing.T) {
 	truthy := collection.Make[string]().Empty()
 	assert.Equal(t, true, truthy)
@@ -293,6 +816,10 @@ func TestSet(t *testing.T) {
 
 	col.Set(4, 5)
 	assert.Equal(t, []int{1, 2, 3, 0, 5}, col.All())
+
+	assert.PanicsWithError(t, "index out of range: index -1, length 5", func() {
+		col.Set(-1, 0)
+	})
 }
 
 func TestSafeSet(t *testing.T) {
@@ -317,6 +844,33 @@ func TestEach(t *testing.T) {
 	assert.Equal(t, 15, incr)
 }
 
+func TestEachReverse(t *testing.T) {
+	var indexes, values []int
+
+	collection.From([]int{10, 20, 30}).EachReverse(func(i int, value int) {
+		indexes = append(indexes, i)
+		values = append(values, value)
+	})
+
+	assert.Equal(t, []int{2, 1, 0}, indexes)
+	assert.Equal(t, []int{30, 20, 10}, values)
+}
+
+func TestEachStep(t *testing.T) {
+	var indexes []int
+
+	collection.FromRange(1, 7).EachStep(3, func(i int, value int) {
+		indexes = append(indexes, i)
+	})
+	assert.Equal(t, []int{0, 3, 6}, indexes)
+
+	called := false
+	collection.FromRange(1, 7).EachStep(0, func(i int, value int) {
+		called = true
+	})
+	assert.False(t, called)
+}
+
 func TestEachCtx(t *testing.T) {
 	col := collection.From([]int{1, 2, 3, 4, 5})
 	incr := 0
@@ -360,11 +914,95 @@ func TestRandom(t *testing.T) {
 	assert.Equal(t, []int{2, 4, 1, 1, 2, 1, 5, 2, 3, 5}, col.Random(r, 10).All())
 }
 
+func TestShuffle(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	col := collection.FromRange(1, 10).Collection
+	shuffled := col.Shuffle(r)
+
+	assert.ElementsMatch(t, col.All(), shuffled.All())
+	assert.NotEqual(t, col.All(), shuffled.All())
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, col.All())
+}
+
+func TestSample(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	col := collection.FromRange(1, 10).Collection
+	sample := col.Sample(r, 4)
+
+	assert.Equal(t, 4, sample.Count())
+	assert.Equal(t, 4, sample.Unique().Count())
+	assert.Empty(t, sample.Diff(col).All())
+
+	all := col.Sample(r, 20)
+	assert.ElementsMatch(t, col.All(), all.All())
+
+	assert.Equal(t, 0, col.Sample(r, -1).Count())
+}
+
 func TestReverse(t *testing.T) {
 	col := collection.From([]int{1, 2, 3, 4, 5})
 	assert.Equal(t, []int{5, 4, 3, 2, 1}, col.Reverse().All())
 }
 
+func TestFilterInPlace(t *testing.T) {
+	backing := []int{1, 2, 3, 4, 5}
+	col := collection.From(backing)
+	col.FilterInPlace(func(i int, value int) bool {
+		return value%2 == 1
+	})
+
+	assert.Equal(t, []int{1, 3, 5}, col.All())
+	assert.Equal(t, []int{1, 3, 5, 0, 0}, backing)
+}
+
+func TestMapInPlace(t *testing.T) {
+	backing := []int{1, 2, 3}
+	col := collection.From(backing)
+	col.MapInPlace(func(i int, value int) int {
+		return value * 2
+	})
+
+	assert.Equal(t, []int{2, 4, 6}, col.All())
+	assert.Equal(t, []int{2, 4, 6}, backing)
+}
+
+func TestReverseInPlace(t *testing.T) {
+	even := collection.From([]int{1, 2, 3, 4})
+	even.ReverseInPlace()
+	assert.Equal(t, []int{4, 3, 2, 1}, even.All())
+
+	odd := collection.From([]int{1, 2, 3, 4, 5})
+	odd.ReverseInPlace()
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, odd.All())
+}
+
+func TestContainsAndIndexOf(t *testing.T) {
+	col := collection.From([]string{"a", "b", "a", "c"})
+
+	assert.True(t, col.Contains("b"))
+	assert.False(t, col.Contains("d"))
+	assert.Equal(t, 0, col.IndexOf("a"))
+	assert.Equal(t, 2, col.LastIndexOf("a"))
+	assert.Equal(t, -1, col.IndexOf("d"))
+	assert.Equal(t, -1, col.LastIndexOf("d"))
+}
+
+func TestIndicesWhere(t *testing.T) {
+	col := collection.From([]int{4, 7, 2, 9, 6})
+
+	odd := col.IndicesWhere(func(i int, value int) bool {
+		return value%2 == 1
+	})
+	assert.Equal(t, []int{1, 3}, odd)
+
+	none := col.IndicesWhere(func(i int, value int) bool {
+		return value > 10
+	})
+	assert.Empty(t, none)
+}
+
 func TestSearch(t *testing.T) {
 	res := collection.FromRange(1, 5).Search(func(i int, value int) bool {
 		return value == 3
@@ -394,3 +1032,43 @@ func TestSafeSearch(t *testing.T) {
 	assert.ErrorIs(t, err, collection.ErrNoItem)
 	assert.Equal(t, -1, notFound)
 }
+
+func TestDiffKeepsDuplicatesAndOrder(t *testing.T) {
+	diff := collection.From([]string{"b", "a", "c", "a", "d"}).Diff(collection.From([]string{"c"}))
+	assert.Equal(t, []string{"b", "a", "a", "d"}, diff.All())
+
+	empty := collection.From([]int{1, 2}).Diff(collection.Make[int]())
+	assert.Equal(t, []int{1, 2}, empty.All())
+}
+
+func BenchmarkMap(b *testing.B) {
+	col := collection.FromRange(1, 10000).Collection
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		col.Map(func(i int, value int) int {
+			return value * 2
+		})
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	col := collection.FromRange(1, 10000).Collection
+
+	for _, bench := range []struct {
+		name string
+		keep func(i int, value int) bool
+	}{
+		{"all", func(i int, value int) bool { return true }},
+		{"half", func(i int, value int) bool { return value%2 == 0 }},
+		{"few", func(i int, value int) bool { return value%1000 == 0 }},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for n := 0; n < b.N; n++ {
+				col.Filter(bench.keep)
+			}
+		})
+	}
+}
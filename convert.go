@@ -0,0 +1,40 @@
+package collection
+
+import "fmt"
+
+// Convert uses cast to convert each item in the collection into another type.
+func Convert[T comparable, U comparable](c Collection[T], cast func(v T) U) Collection[U] {
+	new := make([]U, c.Count())
+	for i, v := range c.contents {
+		new[i] = cast(v)
+	}
+
+	return From(new)
+}
+
+// Pluck returns the result of fn for each item in the collection, such as one
+// field of each struct. It is the same as Convert, named for this common use.
+func Pluck[T comparable, V comparable](c Collection[T], fn func(v T) V) Collection[V] {
+	return Convert(c, fn)
+}
+
+// ConvertChecked converts a collection of numbers into another numeric type,
+// such as []int64 into []int32. Unlike a plain conversion, which silently wraps
+// or truncates values that don't fit in the new type, an ErrOverflow error is
+// returned for the first item that can't be represented exactly.
+func ConvertChecked[T numeric | u, U numeric | u](c Collection[T]) (Collection[U], error) {
+	new := make([]U, c.Count())
+	for i, v := range c.contents {
+		to := U(v)
+
+		// NaN is never equal to itself, but survives conversion between floats.
+		nan := v != v && to != to
+		if !nan && (T(to) != v || (v < 0) != (to < 0)) {
+			return Collection[U]{}, fmt.Errorf("%w: item %d (%v) does not fit in %T", ErrOverflow, i, v, to)
+		}
+
+		new[i] = to
+	}
+
+	return From(new), nil
+}
@@ -0,0 +1,58 @@
+package collection_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+
+	res := collection.Convert(col, strconv.Itoa)
+
+	assert.Equal(t, []string{"1", "2", "3"}, res.All())
+}
+
+func TestPluck(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	users := collection.From([]user{{Name: "ann", Age: 31}, {Name: "bob", Age: 27}})
+
+	names := collection.Pluck(users, func(u user) string {
+		return u.Name
+	})
+
+	assert.Equal(t, []string{"ann", "bob"}, names.All())
+}
+
+func TestConvertChecked(t *testing.T) {
+	widened, err := collection.ConvertChecked[int32, int64](collection.From([]int32{-1, 0, math.MaxInt32}))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{-1, 0, math.MaxInt32}, widened.All())
+
+	narrowed, err := collection.ConvertChecked[int64, int8](collection.From([]int64{-128, 127}))
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{-128, 127}, narrowed.All())
+
+	_, err = collection.ConvertChecked[int64, int8](collection.From([]int64{1, 128}))
+	assert.True(t, errors.Is(err, collection.ErrOverflow))
+	assert.EqualError(t, err, "value out of range for type: item 1 (128) does not fit in int8")
+
+	_, err = collection.ConvertChecked[int8, uint8](collection.From([]int8{-1}))
+	assert.True(t, errors.Is(err, collection.ErrOverflow))
+
+	_, err = collection.ConvertChecked[float64, int](collection.From([]float64{1.5}))
+	assert.True(t, errors.Is(err, collection.ErrOverflow))
+
+	floats, err := collection.ConvertChecked[float64, float32](collection.From([]float64{0.5, math.NaN()}))
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0.5), floats.At(0))
+}
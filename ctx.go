@@ -0,0 +1,127 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gostalt/collection/join"
+)
+
+// ctxCheckInterval is the number of items processed between each check of the
+// context by the context-aware operations, to keep the cost of checking low.
+const ctxCheckInterval = 1024
+
+// ProgressError is returned when a context-aware operation is stopped because
+// its context is Done. It records how much of the work had been completed.
+type ProgressError struct {
+	Done  int
+	Total int
+	Err   error
+}
+
+func (e *ProgressError) Error() string {
+	return fmt.Sprintf("%v after %d of %d items", e.Err, e.Done, e.Total)
+}
+
+func (e *ProgressError) Unwrap() error {
+	return e.Err
+}
+
+// ctxErr returns a *ProgressError if done is a multiple of ctxCheckInterval and
+// the context is Done.
+func ctxErr(ctx context.Context, done int, total int) error {
+	if done%ctxCheckInterval != 0 {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return &ProgressError{Done: done, Total: total, Err: err}
+	}
+
+	return nil
+}
+
+// JoinCtx works in the same way as Join, but stops and returns a
+// *ProgressError if the given context is Done before every item is joined.
+func (c Collection[T]) JoinCtx(ctx context.Context, format join.Method) (string, error) {
+	var b strings.Builder
+
+	for i, v := range c.contents {
+		if err := ctxErr(ctx, i, c.Count()); err != nil {
+			return "", err
+		}
+
+		if i > 0 && i == c.Count()-1 && format.Final != "" {
+			b.WriteString(format.Final)
+		} else if i > 0 {
+			b.WriteString(format.Between)
+		}
+
+		fmt.Fprintf(&b, "%v", v)
+	}
+
+	return b.String(), nil
+}
+
+// SumCtx works in the same way as Sum, but stops and returns a *ProgressError
+// if the given context is Done before every item is added. The total of the
+// items added so far is returned along with the error.
+func (c NumericCollection[T]) SumCtx(ctx context.Context) (T, error) {
+	var total T = 0
+
+	for i, v := range c.contents {
+		if err := ctxErr(ctx, i, c.Count()); err != nil {
+			return total, err
+		}
+
+		total = total + v
+	}
+
+	return total, nil
+}
+
+// SortCtx returns a new collection with the items sorted using less, keeping
+// equal items in their original order. If the given context is Done before
+// the sort is finished, it stops and returns a *ProgressError, counting the
+// items moved by each pass of the sort.
+func (c Collection[T]) SortCtx(ctx context.Context, less func(a T, b T) bool) (Collection[T], error) {
+	n := c.Count()
+	src := make([]T, n)
+	copy(src, c.contents)
+	dst := make([]T, n)
+
+	passes := 0
+	for width := 1; width < n; width *= 2 {
+		passes++
+	}
+
+	done := 0
+	for width := 1; width < n; width *= 2 {
+		// Merge each pair of neighbouring runs of width items from src into dst.
+		for lo := 0; lo < n; lo += 2 * width {
+			mid := clamp(lo+width, 0, n)
+			hi := clamp(lo+2*width, 0, n)
+
+			i, j := lo, mid
+			for k := lo; k < hi; k++ {
+				if err := ctxErr(ctx, done, n*passes); err != nil {
+					return Collection[T]{}, err
+				}
+				done++
+
+				if i < mid && (j == hi || !less(src[j], src[i])) {
+					dst[k] = src[i]
+					i++
+				} else {
+					dst[k] = src[j]
+					j++
+				}
+			}
+		}
+
+		src, dst = dst, src
+	}
+
+	return From(src), nil
+}
@@ -0,0 +1,73 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/gostalt/collection/join"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinCtx(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+
+	res, err := col.JoinCtx(context.Background(), join.ListJoin)
+	assert.NoError(t, err)
+	assert.Equal(t, col.Join(join.ListJoin), res)
+
+	res, err = col.JoinCtx(context.Background(), join.CommaSeparatedJoin)
+	assert.NoError(t, err)
+	assert.Equal(t, "1, 2, 3", res)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = collection.FromRange(1, 5000).JoinCtx(ctx, join.CommaSeparatedJoin)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.EqualError(t, err, "context canceled after 0 of 5000 items")
+}
+
+func TestSumCtx(t *testing.T) {
+	sum, err := collection.FromRange(1, 100).SumCtx(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5050, sum)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = collection.FromRange(1, 100).SumCtx(ctx)
+	var progress *collection.ProgressError
+	assert.True(t, errors.As(err, &progress))
+	assert.Equal(t, 100, progress.Total)
+}
+
+func TestSortCtx(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	col := collection.FromRange(1, 3000).Shuffle(r)
+
+	sorted, err := col.SortCtx(context.Background(), func(a int, b int) bool {
+		return a < b
+	})
+	assert.NoError(t, err)
+	assert.True(t, sort.IntsAreSorted(sorted.All()))
+	assert.Equal(t, 3000, sorted.Count())
+
+	type item struct{ key, order int }
+	stable, err := collection.From([]item{{2, 0}, {1, 1}, {2, 2}, {1, 3}}).SortCtx(context.Background(), func(a item, b item) bool {
+		return a.key < b.key
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []item{{1, 1}, {1, 3}, {2, 0}, {2, 2}}, stable.All())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = col.SortCtx(ctx, func(a int, b int) bool {
+		return a < b
+	})
+	assert.True(t, errors.Is(err, context.Canceled))
+}
@@ -0,0 +1,25 @@
+package collection
+
+// Derived returns the result of passing the collection to fn, caching it under
+// the given name. Later calls with the same name return the cached result
+// without calling fn, until the collection is changed through one of its
+// pointer receiver methods, such as Set or Pop, after which the result is
+// recomputed on the next call.
+//
+// Changes made directly to the slice returned by All can't be detected, and do
+// not invalidate the cache. The cached collection is shared between calls, so it
+// should not be modified.
+func (c *Collection[T]) Derived(name string, fn func(c Collection[T]) Collection[T]) Collection[T] {
+	if cached, ok := c.derived[name]; ok {
+		return cached
+	}
+
+	if c.derived == nil {
+		c.derived = make(map[string]Collection[T])
+	}
+
+	result := fn(*c)
+	c.derived[name] = result
+
+	return result
+}
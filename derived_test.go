@@ -0,0 +1,36 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerived(t *testing.T) {
+	calls := 0
+	evens := func(c collection.Collection[int]) collection.Collection[int] {
+		calls++
+		return c.Filter(func(i int, value int) bool {
+			return value%2 == 0
+		})
+	}
+
+	col := collection.From([]int{1, 2, 3, 4})
+
+	assert.Equal(t, []int{2, 4}, col.Derived("evens", evens).All())
+	assert.Equal(t, []int{2, 4}, col.Derived("evens", evens).All())
+	assert.Equal(t, 1, calls)
+
+	col.Set(0, 6)
+	assert.Equal(t, []int{6, 2, 4}, col.Derived("evens", evens).All())
+	assert.Equal(t, 2, calls)
+
+	col.Pop(1)
+	assert.Equal(t, []int{6, 2}, col.Derived("evens", evens).All())
+	assert.Equal(t, 3, calls)
+
+	appended := col.Append(8)
+	assert.Equal(t, []int{6, 2, 8}, appended.Derived("evens", evens).All())
+	assert.Equal(t, 4, calls)
+}
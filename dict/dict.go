@@ -0,0 +1,292 @@
+// Package dict provides Dict, a keyed collection backed by a map, which keeps
+// its keys in the order they were first added.
+package dict
+
+import (
+	"sort"
+
+	"github.com/gostalt/collection"
+)
+
+// Dict is a collection of values indexed by unique keys. Unlike a map, a Dict
+// iterates over its keys in the order they were first added. The zero value is
+// an empty Dict ready to use.
+//
+// Every method has a pointer receiver, and a Dict holds both a map and a slice
+// of entries, so a Dict must not be copied after first use. Pass *Dict instead.
+type Dict[K comparable, V any] struct {
+	// entries holds every key and value in order. Deleted entries are left in
+	// place, and removed once they make up half of the slice, so that Delete
+	// doesn't need to shift every later entry.
+	entries []entry[K, V]
+	// index maps each key to the position of its entry.
+	index   map[K]int
+	deleted int
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	deleted bool
+}
+
+// New returns a new empty Dict.
+func New[K comparable, V any]() *Dict[K, V] {
+	return &Dict[K, V]{index: make(map[K]int)}
+}
+
+// withCapacity returns a new empty Dict with room for n keys.
+func withCapacity[K comparable, V any](n int) *Dict[K, V] {
+	return &Dict[K, V]{entries: make([]entry[K, V], 0, n), index: make(map[K]int, n)}
+}
+
+// FromMap creates a new Dict from a copy of the provided map. As maps are
+// unordered, the order of the Dict's keys is unspecified; use FromCollection
+// with collection.FromMap, or SortKeys, to order the keys.
+func FromMap[K comparable, V any](m map[K]V) *Dict[K, V] {
+	d := withCapacity[K, V](len(m))
+	for k, v := range m {
+		d.Set(k, v)
+	}
+
+	return d
+}
+
+// FromCollection creates a new Dict from a collection of pairs, in the order
+// of the collection. If more than one pair has the same key, the key keeps the
+// position of its first pair and the value of its last.
+func FromCollection[K comparable, V comparable](c collection.Collection[collection.Pair[K, V]]) *Dict[K, V] {
+	d := withCapacity[K, V](c.Count())
+	c.Each(func(i int, p collection.Pair[K, V]) {
+		d.Set(p.Key, p.Value)
+	})
+
+	return d
+}
+
+// ToCollection returns the Dict's keys and values as a collection of pairs, in
+// the order of the keys. It is a func rather than a method, as collections can
+// only hold comparable values.
+func ToCollection[K comparable, V comparable](d *Dict[K, V]) collection.Collection[collection.Pair[K, V]] {
+	pairs := make([]collection.Pair[K, V], 0, d.Count())
+	d.Each(func(k K, v V) {
+		pairs = append(pairs, collection.Pair[K, V]{Key: k, Value: v})
+	})
+
+	return collection.From(pairs)
+}
+
+// ToMap returns a copy of the Dict's keys and values as a map.
+func (d *Dict[K, V]) ToMap() map[K]V {
+	m := make(map[K]V, d.Count())
+	d.Each(func(k K, v V) {
+		m[k] = v
+	})
+
+	return m
+}
+
+// Count returns the number of keys in the Dict.
+func (d *Dict[K, V]) Count() int {
+	return len(d.index)
+}
+
+// Get returns the value for the given key, and whether the key exists.
+func (d *Dict[K, V]) Get(k K) (V, bool) {
+	i, ok := d.index[k]
+	if !ok {
+		return *new(V), false
+	}
+
+	return d.entries[i].value, true
+}
+
+// Has returns true if the given key exists in the Dict.
+func (d *Dict[K, V]) Has(k K) bool {
+	_, ok := d.index[k]
+	return ok
+}
+
+// Set updates the value for the given key, adding the key to the end of the
+// Dict if it doesn't already exist.
+func (d *Dict[K, V]) Set(k K, v V) {
+	if i, ok := d.index[k]; ok {
+		d.entries[i].value = v
+		return
+	}
+
+	if d.index == nil {
+		d.index = make(map[K]int)
+	}
+
+	d.index[k] = len(d.entries)
+	d.entries = append(d.entries, entry[K, V]{key: k, value: v})
+}
+
+// Delete removes the given key from the Dict, if it exists.
+func (d *Dict[K, V]) Delete(k K) {
+	i, ok := d.index[k]
+	if !ok {
+		return
+	}
+
+	delete(d.index, k)
+	d.entries[i] = entry[K, V]{deleted: true}
+	d.deleted++
+
+	if d.deleted > len(d.entries)/2 {
+		d.compact()
+	}
+}
+
+// compact removes deleted entries, and updates the index to match.
+func (d *Dict[K, V]) compact() {
+	live := d.entries[:0]
+	for _, e := range d.entries {
+		if !e.deleted {
+			d.index[e.key] = len(live)
+			live = append(live, e)
+		}
+	}
+
+	// Clear the entries past the end, so their values can be garbage
+	// collected.
+	for i := len(live); i < len(d.entries); i++ {
+		d.entries[i] = entry[K, V]{}
+	}
+
+	d.entries = live
+	d.deleted = 0
+}
+
+// Keys returns the Dict's keys, in order.
+func (d *Dict[K, V]) Keys() collection.Collection[K] {
+	keys := make([]K, 0, d.Count())
+	d.Each(func(k K, v V) {
+		keys = append(keys, k)
+	})
+
+	return collection.From(keys)
+}
+
+// Values returns the Dict's values, in the order of their keys.
+func (d *Dict[K, V]) Values() []V {
+	values := make([]V, 0, d.Count())
+	d.Each(func(k K, v V) {
+		values = append(values, v)
+	})
+
+	return values
+}
+
+// Each iterates over each key in the Dict, in order, and passes the key and its
+// value to the provided func.
+func (d *Dict[K, V]) Each(fn func(k K, v V)) {
+	for _, e := range d.entries {
+		if !e.deleted {
+			fn(e.key, e.value)
+		}
+	}
+}
+
+// Filter returns a new Dict holding only the keys for which the predicate
+// returns true.
+func (d *Dict[K, V]) Filter(predicate func(k K, v V) bool) *Dict[K, V] {
+	new := New[K, V]()
+	d.Each(func(k K, v V) {
+		if predicate(k, v) {
+			new.Set(k, v)
+		}
+	})
+
+	return new
+}
+
+// Map returns a new Dict with the same keys, and each value replaced by the
+// result of passing it to fn. Use MapValues to change the type of the values.
+func (d *Dict[K, V]) Map(fn func(k K, v V) V) *Dict[K, V] {
+	return MapValues(d, fn)
+}
+
+// MapValues returns a new Dict with the same keys, and each value replaced by
+// the result of passing it to fn, which may return a different type. It is a
+// func rather than a method, as methods can't have their own type parameters.
+func MapValues[K comparable, V any, U any](d *Dict[K, V], fn func(k K, v V) U) *Dict[K, U] {
+	new := withCapacity[K, U](d.Count())
+	d.Each(func(k K, v V) {
+		new.Set(k, fn(k, v))
+	})
+
+	return new
+}
+
+// SortKeys returns a new Dict with the same keys and values, with the keys
+// ordered using the given less func. Keys that are equal according to less keep
+// their original order.
+func (d *Dict[K, V]) SortKeys(less func(a K, b K) bool) *Dict[K, V] {
+	sorted := d.Filter(func(k K, v V) bool {
+		return true
+	})
+
+	sort.SliceStable(sorted.entries, func(i, j int) bool {
+		return less(sorted.entries[i].key, sorted.entries[j].key)
+	})
+	for i, e := range sorted.entries {
+		sorted.index[e.key] = i
+	}
+
+	return sorted
+}
+
+// Merge returns a new Dict holding the keys of both Dicts. Where both Dicts
+// hold the same key, the value from other is used.
+func (d *Dict[K, V]) Merge(other *Dict[K, V]) *Dict[K, V] {
+	new := d.Filter(func(k K, v V) bool {
+		return true
+	})
+	other.Each(new.Set)
+
+	return new
+}
+
+// Only returns a new Dict holding only the given keys.
+func (d *Dict[K, V]) Only(keys ...K) *Dict[K, V] {
+	wanted := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		wanted[k] = struct{}{}
+	}
+
+	return d.Filter(func(k K, v V) bool {
+		_, ok := wanted[k]
+		return ok
+	})
+}
+
+// Except returns a new Dict holding every key except the given keys.
+func (d *Dict[K, V]) Except(keys ...K) *Dict[K, V] {
+	unwanted := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		unwanted[k] = struct{}{}
+	}
+
+	return d.Filter(func(k K, v V) bool {
+		_, ok := unwanted[k]
+		return !ok
+	})
+}
+
+// GroupBy groups the items of the collection by the key returned by the given
+// func. Keys are ordered by the first item in each group, and items within each
+// group keep their original relative order.
+func GroupBy[T comparable, K comparable](c collection.Collection[T], key func(v T) K) *Dict[K, collection.Collection[T]] {
+	groups := New[K, []T]()
+	for _, v := range c.All() {
+		k := key(v)
+		group, _ := groups.Get(k)
+		groups.Set(k, append(group, v))
+	}
+
+	return MapValues(groups, func(k K, values []T) collection.Collection[T] {
+		return collection.From(values)
+	})
+}
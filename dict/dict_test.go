@@ -0,0 +1,164 @@
+package dict_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/gostalt/collection/dict"
+	"github.com/stretchr/testify/assert"
+)
+
+func prices() *dict.Dict[string, int] {
+	return dict.FromCollection(collection.Zip(
+		collection.From([]string{"apple", "banana", "cherry"}),
+		collection.From([]int{3, 1, 5}),
+	))
+}
+
+func TestSetGetAndDelete(t *testing.T) {
+	var d dict.Dict[string, int]
+
+	d.Set("b", 2)
+	d.Set("a", 1)
+	d.Set("b", 3)
+
+	v, ok := d.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	assert.False(t, d.Has("c"))
+	assert.Equal(t, []string{"b", "a"}, d.Keys().All())
+
+	d.Delete("b")
+	d.Delete("missing")
+	assert.Equal(t, 1, d.Count())
+	assert.Equal(t, []string{"a"}, d.Keys().All())
+}
+
+func TestKeysAndValues(t *testing.T) {
+	d := prices()
+
+	assert.Equal(t, []string{"apple", "banana", "cherry"}, d.Keys().All())
+	assert.Equal(t, []int{3, 1, 5}, d.Values())
+	assert.Equal(t, map[string]int{"apple": 3, "banana": 1, "cherry": 5}, d.ToMap())
+	assert.Equal(t, collection.KeysOf(dict.ToCollection(d)).All(), d.Keys().All())
+	assert.Equal(t, collection.ValuesOf(dict.ToCollection(d)).All(), d.Values())
+}
+
+func TestFromMap(t *testing.T) {
+	d := dict.FromMap(map[string]int{"a": 1, "b": 2})
+
+	assert.Equal(t, 2, d.Count())
+	assert.ElementsMatch(t, []string{"a", "b"}, d.Keys().All())
+}
+
+func TestFilterAndMap(t *testing.T) {
+	d := prices()
+
+	cheap := d.Filter(func(k string, v int) bool {
+		return v < 4
+	})
+	assert.Equal(t, []string{"apple", "banana"}, cheap.Keys().All())
+
+	doubled := d.Map(func(k string, v int) int {
+		return v * 2
+	})
+	assert.Equal(t, []int{6, 2, 10}, doubled.Values())
+	assert.Equal(t, []int{3, 1, 5}, d.Values())
+}
+
+func TestEach(t *testing.T) {
+	keys := []string{}
+	prices().Each(func(k string, v int) {
+		keys = append(keys, k)
+	})
+
+	assert.Equal(t, []string{"apple", "banana", "cherry"}, keys)
+}
+
+func TestMerge(t *testing.T) {
+	other := dict.New[string, int]()
+	other.Set("banana", 2)
+	other.Set("date", 4)
+
+	merged := prices().Merge(other)
+
+	assert.Equal(t, []string{"apple", "banana", "cherry", "date"}, merged.Keys().All())
+	assert.Equal(t, []int{3, 2, 5, 4}, merged.Values())
+}
+
+func TestOnlyAndExcept(t *testing.T) {
+	d := prices()
+
+	assert.Equal(t, []string{"apple", "cherry"}, d.Only("cherry", "apple", "missing").Keys().All())
+	assert.Equal(t, []string{"banana"}, d.Except("cherry", "apple").Keys().All())
+}
+
+func TestNonComparableValues(t *testing.T) {
+	var d dict.Dict[string, []int]
+
+	d.Set("a", []int{1, 2})
+	d.Set("b", nil)
+
+	assert.Equal(t, [][]int{{1, 2}, nil}, d.Values())
+}
+
+func TestGroupBy(t *testing.T) {
+	groups := dict.GroupBy(collection.From([]int{1, 2, 3, 4, 5, 6}), func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+
+	assert.Equal(t, []string{"odd", "even"}, groups.Keys().All())
+
+	odd, _ := groups.Get("odd")
+	assert.Equal(t, []int{1, 3, 5}, odd.All())
+
+	even, _ := groups.Get("even")
+	assert.Equal(t, []int{2, 4, 6}, even.All())
+}
+
+func TestDeleteManyKeepsOrder(t *testing.T) {
+	d := dict.New[int, int]()
+	for i := 0; i < 10; i++ {
+		d.Set(i, i*i)
+	}
+
+	for i := 0; i < 10; i += 3 {
+		d.Delete(i)
+	}
+	d.Delete(1)
+	d.Set(0, 100)
+
+	assert.Equal(t, []int{2, 4, 5, 7, 8, 0}, d.Keys().All())
+	assert.Equal(t, []int{4, 16, 25, 49, 64, 100}, d.Values())
+
+	v, ok := d.Get(8)
+	assert.True(t, ok)
+	assert.Equal(t, 64, v)
+	assert.False(t, d.Has(9))
+}
+
+func TestSortKeys(t *testing.T) {
+	d := prices().SortKeys(func(a string, b string) bool {
+		return a > b
+	})
+
+	assert.Equal(t, []string{"cherry", "banana", "apple"}, d.Keys().All())
+	assert.Equal(t, []int{5, 1, 3}, d.Values())
+
+	v, _ := d.Get("banana")
+	assert.Equal(t, 1, v)
+	assert.Equal(t, []string{"apple", "banana", "cherry"}, prices().Keys().All())
+}
+
+func TestMapValues(t *testing.T) {
+	labels := dict.MapValues(prices(), func(k string, v int) string {
+		return k + ":" + strconv.Itoa(v)
+	})
+
+	assert.Equal(t, []string{"apple", "banana", "cherry"}, labels.Keys().All())
+	assert.Equal(t, []string{"apple:3", "banana:1", "cherry:5"}, labels.Values())
+}
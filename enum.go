@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"fmt"
+
+	"github.com/gostalt/collection/join"
+)
+
+// Enumeration is a fixed set of allowed values, such as the valid values of a
+// request field, that collections can be checked against.
+type Enumeration[T comparable] struct {
+	allowed Collection[T]
+	lookup  map[T]struct{}
+}
+
+// Enum returns an Enumeration of the given allowed values.
+func Enum[T comparable](allowed ...T) Enumeration[T] {
+	lookup := make(map[T]struct{}, len(allowed))
+	for _, v := range allowed {
+		lookup[v] = struct{}{}
+	}
+
+	return Enumeration[T]{
+		allowed: FromCopy(allowed),
+		lookup:  lookup,
+	}
+}
+
+// Allowed returns the allowed values, in the order they were given to Enum.
+func (e Enumeration[T]) Allowed() Collection[T] {
+	return e.allowed.Clone()
+}
+
+// Contains returns true if v is one of the allowed values.
+func (e Enumeration[T]) Contains(v T) bool {
+	_, ok := e.lookup[v]
+	return ok
+}
+
+// Validate checks that every item in the collection is one of the allowed
+// values. For the first item that isn't, an *ItemError is returned wrapping
+// ErrNotAllowed, with a message listing the allowed values.
+func (e Enumeration[T]) Validate(c Collection[T]) error {
+	for i, v := range c.contents {
+		if !e.Contains(v) {
+			return &ItemError{
+				Index: i,
+				Err:   fmt.Errorf("%w: %v is not one of %s", ErrNotAllowed, v, e.allowed.Join(join.CommaSeparatedJoin)),
+			}
+		}
+	}
+
+	return nil
+}
+
+// Coerce returns a copy of the collection with every item that isn't one of the
+// allowed values replaced by fallback.
+func (e Enumeration[T]) Coerce(c Collection[T], fallback T) Collection[T] {
+	return c.Map(func(i int, value T) T {
+		if e.Contains(value) {
+			return value
+		}
+
+		return fallback
+	})
+}
@@ -0,0 +1,35 @@
+package collection_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumValidate(t *testing.T) {
+	sizes := collection.Enum("small", "medium", "large")
+
+	assert.True(t, sizes.Contains("small"))
+	assert.False(t, sizes.Contains("huge"))
+	assert.Equal(t, []string{"small", "medium", "large"}, sizes.Allowed().All())
+
+	assert.NoError(t, sizes.Validate(collection.From([]string{"large", "small"})))
+
+	err := sizes.Validate(collection.From([]string{"small", "huge", "tiny"}))
+	assert.True(t, errors.Is(err, collection.ErrNotAllowed))
+	assert.EqualError(t, err, "item 1: value not allowed: huge is not one of small, medium, large")
+
+	var itemErr *collection.ItemError
+	assert.True(t, errors.As(err, &itemErr))
+	assert.Equal(t, 1, itemErr.Index)
+}
+
+func TestEnumCoerce(t *testing.T) {
+	sizes := collection.Enum("small", "medium", "large")
+
+	res := sizes.Coerce(collection.From([]string{"small", "huge", "large"}), "medium")
+
+	assert.Equal(t, []string{"small", "medium", "large"}, res.All())
+}
@@ -1,7 +1,51 @@
 package collection
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var ErrNoItem = errors.New("item not found")
 
 var ErrIndexOutOfRange = errors.New("index out of range")
+
+var ErrInvalidExpression = errors.New("invalid expression")
+
+var ErrUnknownCollection = errors.New("unknown collection")
+
+var ErrNotStruct = errors.New("type is not a struct")
+
+var ErrFieldType = errors.New("value cannot be assigned to field")
+
+var ErrConcurrentModification = errors.New("collection modified during iteration")
+
+var ErrCorruptJournal = errors.New("corrupt journal")
+
+var ErrCorruptSnapshot = errors.New("corrupt snapshot")
+
+var ErrVersionUnavailable = errors.New("version unavailable")
+
+var ErrVersionMismatch = errors.New("version mismatch")
+
+var ErrOverflow = errors.New("value out of range for type")
+
+var ErrNotAllowed = errors.New("value not allowed")
+
+// IndexError records an index that was out of range for a collection, and the
+// length of the collection at the time. It wraps ErrNoItem or
+// ErrIndexOutOfRange, so it can still be checked for with errors.Is.
+type IndexError struct {
+	Index int
+	Len   int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("%v: index %d, length %d", e.Err, e.Index, e.Len)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+var ErrLengthMismatch = errors.New("collection lengths differ")
@@ -0,0 +1,234 @@
+package collection
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// Named associates a collection with the name used to refer to it in an
+// expression passed to Eval.
+type Named[T comparable] struct {
+	Name       string
+	Collection Collection[T]
+}
+
+// Name returns the given collection as a Named collection, ready to be passed
+// to Eval.
+func Name[T comparable](name string, c Collection[T]) Named[T] {
+	return Named[T]{Name: name, Collection: c}
+}
+
+// Eval evaluates a set expression against the given named collections. For
+// example, Eval("(A ∪ B) \\ C", Name("A", a), Name("B", b), Name("C", c))
+// returns the unique items found in either a or b, but not in c.
+//
+// The following operators are supported, and can be grouped with parentheses:
+//   - union, written as "∪" or "|"
+//   - intersection, written as "∩" or "&"
+//   - difference, written as "\" or "-"
+//
+// Intersection binds more tightly than union and difference, which are
+// evaluated left to right. The result contains each item once, in the order it
+// first appears in the left-most operand that contains it.
+//
+// Each named collection is indexed at most once, however many times it appears
+// in the expression. An error wrapping collection.ErrInvalidExpression or
+// collection.ErrUnknownCollection is returned if the expression can't be
+// evaluated.
+func Eval[T comparable](expr string, collections ...Named[T]) (Collection[T], error) {
+	p := evalParser[T]{
+		tokens:  []rune(expr),
+		named:   make(map[string]Collection[T], len(collections)),
+		indexes: make(map[string]evalSet[T], len(collections)),
+	}
+
+	for _, n := range collections {
+		p.named[n.Name] = n.Collection
+	}
+
+	set, err := p.parseExpr()
+	if err != nil {
+		return Make[T](), err
+	}
+
+	p.skipSpace()
+	if p.pos < len(p.tokens) {
+		return Make[T](), fmt.Errorf("%w: unexpected %q at position %d", ErrInvalidExpression, p.tokens[p.pos], p.pos)
+	}
+
+	return From(set.order), nil
+}
+
+// evalSet is an ordered set of unique items, used as the intermediate result of
+// each operation in an expression.
+type evalSet[T comparable] struct {
+	order []T
+	index map[T]struct{}
+}
+
+func (s evalSet[T]) has(v T) bool {
+	_, ok := s.index[v]
+	return ok
+}
+
+func newEvalSet[T comparable](size int) evalSet[T] {
+	return evalSet[T]{
+		order: make([]T, 0, size),
+		index: make(map[T]struct{}, size),
+	}
+}
+
+func (s *evalSet[T]) add(v T) {
+	if s.has(v) {
+		return
+	}
+
+	s.order = append(s.order, v)
+	s.index[v] = struct{}{}
+}
+
+// evalParser is a recursive descent parser that evaluates an expression as it
+// is parsed.
+type evalParser[T comparable] struct {
+	tokens  []rune
+	pos     int
+	named   map[string]Collection[T]
+	indexes map[string]evalSet[T]
+}
+
+func (p *evalParser[T]) skipSpace() {
+	for p.pos < len(p.tokens) && unicode.IsSpace(p.tokens[p.pos]) {
+		p.pos++
+	}
+}
+
+// peek returns the next non-space rune in the expression, or 0 if the end of
+// the expression has been reached.
+func (p *evalParser[T]) peek() rune {
+	p.skipSpace()
+	if p.pos >= len(p.tokens) {
+		return 0
+	}
+
+	return p.tokens[p.pos]
+}
+
+// parseExpr parses a sequence of terms joined by union or difference.
+func (p *evalParser[T]) parseExpr() (evalSet[T], error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return left, err
+	}
+
+	for {
+		op := p.peek()
+		if op != '∪' && op != '|' && op != '\\' && op != '-' {
+			return left, nil
+		}
+		p.pos++
+
+		right, err := p.parseTerm()
+		if err != nil {
+			return right, err
+		}
+
+		result := newEvalSet[T](len(left.order))
+		for _, v := range left.order {
+			if op == '∪' || op == '|' || !right.has(v) {
+				result.add(v)
+			}
+		}
+
+		if op == '∪' || op == '|' {
+			for _, v := range right.order {
+				result.add(v)
+			}
+		}
+
+		left = result
+	}
+}
+
+// parseTerm parses a sequence of factors joined by intersection.
+func (p *evalParser[T]) parseTerm() (evalSet[T], error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return left, err
+	}
+
+	for {
+		op := p.peek()
+		if op != '∩' && op != '&' {
+			return left, nil
+		}
+		p.pos++
+
+		right, err := p.parseFactor()
+		if err != nil {
+			return right, err
+		}
+
+		result := newEvalSet[T](len(left.order))
+		for _, v := range left.order {
+			if right.has(v) {
+				result.add(v)
+			}
+		}
+
+		left = result
+	}
+}
+
+// parseFactor parses either a parenthesised expression or a collection name.
+func (p *evalParser[T]) parseFactor() (evalSet[T], error) {
+	switch r := p.peek(); {
+	case r == 0:
+		return evalSet[T]{}, fmt.Errorf("%w: unexpected end of expression", ErrInvalidExpression)
+	case r == '(':
+		p.pos++
+		set, err := p.parseExpr()
+		if err != nil {
+			return set, err
+		}
+
+		if p.peek() != ')' {
+			return set, fmt.Errorf("%w: missing closing parenthesis at position %d", ErrInvalidExpression, p.pos)
+		}
+		p.pos++
+
+		return set, nil
+	case isNameRune(r):
+		start := p.pos
+		for p.pos < len(p.tokens) && isNameRune(p.tokens[p.pos]) {
+			p.pos++
+		}
+
+		return p.index(string(p.tokens[start:p.pos]))
+	default:
+		return evalSet[T]{}, fmt.Errorf("%w: unexpected %q at position %d", ErrInvalidExpression, r, p.pos)
+	}
+}
+
+// index returns the set for the named collection, building it on first use.
+func (p *evalParser[T]) index(name string) (evalSet[T], error) {
+	if set, ok := p.indexes[name]; ok {
+		return set, nil
+	}
+
+	c, ok := p.named[name]
+	if !ok {
+		return evalSet[T]{}, fmt.Errorf("%w: %s", ErrUnknownCollection, name)
+	}
+
+	set := newEvalSet[T](c.Count())
+	for _, v := range c.All() {
+		set.add(v)
+	}
+	p.indexes[name] = set
+
+	return set, nil
+}
+
+func isNameRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,46 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEval(t *testing.T) {
+	a := collection.Name("A", collection.From([]int{1, 2, 3, 3}))
+	b := collection.Name("B", collection.From([]int{3, 4, 5}))
+	c := collection.Name("C", collection.From([]int{2, 5}))
+
+	res, err := collection.Eval(`(A ∪ B) \ C`, a, b, c)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3, 4}, res.All())
+
+	res, err = collection.Eval("A & B", a, b)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3}, res.All())
+
+	res, err = collection.Eval("A | B ∩ C", a, b, c)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 5}, res.All())
+
+	res, err = collection.Eval("A - B - C", a, b, c)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, res.All())
+}
+
+func TestEvalErrors(t *testing.T) {
+	a := collection.Name("A", collection.From([]int{1, 2, 3}))
+
+	_, err := collection.Eval("A ∪ Z", a)
+	assert.ErrorIs(t, err, collection.ErrUnknownCollection)
+
+	_, err = collection.Eval("(A ∪ A", a)
+	assert.ErrorIs(t, err, collection.ErrInvalidExpression)
+
+	_, err = collection.Eval("A ∪", a)
+	assert.ErrorIs(t, err, collection.ErrInvalidExpression)
+
+	_, err = collection.Eval("A A", a)
+	assert.ErrorIs(t, err, collection.ErrInvalidExpression)
+}
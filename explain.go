@@ -0,0 +1,73 @@
+package collection
+
+import (
+	"fmt"
+	"strings"
+)
+
+// explainLimit is the maximum number of items listed by ExplainDiff for each
+// side of a difference.
+const explainLimit = 10
+
+// Equal returns true if the collection contains the same items as other, in
+// the same order.
+func (c Collection[T]) Equal(other Collection[T]) bool {
+	if c.Count() != other.Count() {
+		return false
+	}
+
+	for i, v := range c.All() {
+		if other.At(i) != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ExplainDiff describes how the collection differs from other, for use in test
+// failure messages and logs. It reports any difference in length, the first
+// index at which the items differ, and the items found in only one of the
+// collections. If the collections are Equal, an empty string is returned.
+func (c Collection[T]) ExplainDiff(other Collection[T]) string {
+	if c.Equal(other) {
+		return ""
+	}
+
+	parts := []string{}
+
+	if c.Count() != other.Count() {
+		parts = append(parts, fmt.Sprintf("length %d != %d", c.Count(), other.Count()))
+	}
+
+	for i := 0; i < c.Count() && i < other.Count(); i++ {
+		if c.At(i) != other.At(i) {
+			parts = append(parts, fmt.Sprintf("first difference at index %d: %v != %v", i, c.At(i), other.At(i)))
+			break
+		}
+	}
+
+	if missing := other.Diff(c); missing.NotEmpty() {
+		parts = append(parts, "missing "+explainItems(missing))
+	}
+
+	if extra := c.Diff(other); extra.NotEmpty() {
+		parts = append(parts, "unexpected "+explainItems(extra))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// explainItems formats the given items as a list, truncated to explainLimit
+// items.
+func explainItems[T comparable](c Collection[T]) string {
+	items := MapInto(c.FirstX(explainLimit), nil, func(i int, value T) string {
+		return fmt.Sprintf("%v", value)
+	})
+
+	if c.Count() > explainLimit {
+		items = append(items, fmt.Sprintf("… %d more", c.Count()-explainLimit))
+	}
+
+	return "[" + strings.Join(items, ", ") + "]"
+}
@@ -0,0 +1,38 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+
+	assert.True(t, col.Equal(collection.FromRange(1, 3).Collection))
+	assert.False(t, col.Equal(collection.From([]int{1, 2})))
+	assert.False(t, col.Equal(collection.From([]int{1, 3, 2})))
+}
+
+func TestExplainDiff(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+
+	assert.Equal(t, "", col.ExplainDiff(collection.From([]int{1, 2, 3})))
+
+	assert.Equal(t,
+		"length 3 != 4; first difference at index 1: 2 != 5; missing [5, 6]; unexpected [2]",
+		col.ExplainDiff(collection.From([]int{1, 5, 3, 6})),
+	)
+
+	assert.Equal(t,
+		"first difference at index 1: 1 != 2",
+		collection.From([]int{1, 1, 2}).ExplainDiff(collection.From([]int{1, 2, 2})),
+	)
+
+	long := collection.FromRange(1, 12).Collection
+	assert.Equal(t,
+		"length 12 != 0; unexpected [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, … 2 more]",
+		long.ExplainDiff(collection.From([]int{})),
+	)
+}
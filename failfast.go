@@ -0,0 +1,43 @@
+package collection
+
+import "sync/atomic"
+
+// FailFast enables fail-fast mode for the collection. In fail-fast mode, any
+// change made through one of the collection's pointer receiver methods, such as
+// Set or Pop, while the collection is being iterated over by one of the Each
+// family of methods causes a panic with collection.ErrConcurrentModification,
+// rather than the iteration silently seeing a mix of old and new data.
+// EachResumable returns the error instead of panicking, and Chan closes its
+// channel early, with ChanErr reporting the error.
+//
+// Fail-fast mode is shared by copies of the collection value, but is not carried
+// over to new collections returned by methods such as Append or Filter.
+func (c *Collection[T]) FailFast() {
+	if c.mods == nil {
+		c.mods = new(atomic.Uint64)
+	}
+}
+
+// modCount returns the number of changes made to the collection since fail-fast
+// mode was enabled.
+func (c Collection[T]) modCount() uint64 {
+	if c.mods == nil {
+		return 0
+	}
+
+	return c.mods.Load()
+}
+
+// modified returns true if the collection is in fail-fast mode and has changed
+// since modCount returned start.
+func (c Collection[T]) modified(start uint64) bool {
+	return c.mods != nil && c.mods.Load() != start
+}
+
+// checkMods panics with ErrConcurrentModification if the collection has changed
+// since modCount returned start.
+func (c Collection[T]) checkMods(start uint64) {
+	if c.modified(start) {
+		panic(ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,82 @@
+package collection_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailFastPanicsOnModificationDuringEach(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+	col.FailFast()
+
+	assert.PanicsWithValue(t, collection.ErrConcurrentModification, func() {
+		col.Each(func(i int, value int) {
+			if i == 0 {
+				col.Pop(1)
+			}
+		})
+	})
+
+	assert.PanicsWithValue(t, collection.ErrConcurrentModification, func() {
+		col.EachCtx(context.Background(), func(i int, value int) {
+			col.Set(0, 5)
+		})
+	})
+}
+
+func TestFailFastAllowsModificationOutsideIteration(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+	col.FailFast()
+	col.Set(0, 5)
+
+	sum := 0
+	assert.NotPanics(t, func() {
+		col.Each(func(i int, value int) {
+			sum += value
+		})
+	})
+	assert.Equal(t, 10, sum)
+}
+
+func TestWithoutFailFastModificationDoesNotPanic(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+
+	assert.NotPanics(t, func() {
+		col.Each(func(i int, value int) {
+			col.Set(i, value*2)
+		})
+	})
+}
+
+func TestFailFastEachResumable(t *testing.T) {
+	col := collection.From([]int{1, 2, 3})
+	col.FailFast()
+
+	token, err := col.EachResumable(context.Background(), collection.ResumeToken{}, func(i int, value int) error {
+		col.MapInPlace(func(i int, value int) int {
+			return value
+		})
+		return nil
+	})
+
+	assert.ErrorIs(t, err, collection.ErrConcurrentModification)
+	assert.Equal(t, 1, token.Next)
+}
+
+func TestFailFastChanErr(t *testing.T) {
+	col := collection.From([]int{1, 2, 3, 4})
+	col.FailFast()
+
+	ch, errFn := col.ChanErr()
+	vals := []int{<-ch}
+	col.Set(0, 5)
+	for v := range ch {
+		vals = append(vals, v)
+	}
+
+	assert.Less(t, len(vals), 4)
+	assert.ErrorIs(t, errFn(), collection.ErrConcurrentModification)
+}
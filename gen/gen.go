@@ -0,0 +1,232 @@
+// Package gen generates specialised, non-generic collection types for structs,
+// with typed accessors and field-based Where and SortBy methods built on top of
+// collection.Collection.
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"text/template"
+	"unicode"
+)
+
+var ErrTypeNotFound = errors.New("struct type not found")
+
+var ErrNotComparable = errors.New("struct is not comparable")
+
+var ErrNameConflict = errors.New("fields generate methods with the same name")
+
+// ordered lists the types whose fields get a SortBy method, where a field's type
+// can't be resolved.
+var ordered = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+	"float32": true, "float64": true, "string": true,
+	"byte": true, "rune": true,
+}
+
+type field struct {
+	Name    string
+	Method  string
+	Type    string
+	Ordered bool
+}
+
+type spec struct {
+	Package string
+	Type    string
+	Fields  []field
+	Sorts   bool
+}
+
+// Generate parses the Go source file src, and returns the source of a
+// collection type for the struct named typeName, in the same package. For a
+// struct named User, the generated UserCollection has:
+//   - NewUserCollection, which creates a UserCollection from a slice
+//   - a <Field>Values method for each field, returning the field of every item
+//   - a Where<Field> method for each field, filtering by the field's value
+//   - a SortBy<Field> method for each field of an ordered type, such as an
+//     integer or a string, or a named type based on one
+//
+// Embedded fields are skipped. An error wrapping ErrNotComparable is returned
+// if the struct has a field that isn't comparable, such as a slice, map or func,
+// including named types declared as one, as collections can only hold
+// comparable types. Types declared in other files of the package can't be
+// checked, so are assumed to be comparable. An error wrapping ErrNameConflict is
+// returned if two fields differ only in the case of their first letter, such as
+// name and Name, as they would generate the same methods.
+func Generate(src []byte, typeName string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	st := findStruct(file, typeName)
+	if st == nil {
+		return nil, fmt.Errorf("%w: %s", ErrTypeNotFound, typeName)
+	}
+
+	info := typeCheck(fset, file)
+
+	s := spec{Package: file.Name.Name, Type: typeName}
+	methods := make(map[string]string)
+	for _, f := range st.Fields.List {
+		typ := types.ExprString(f.Type)
+		comparable, isOrdered := fieldType(f.Type, info)
+		if !comparable {
+			return nil, fmt.Errorf("%w: %s has a field of type %s", ErrNotComparable, typeName, typ)
+		}
+
+		for _, name := range f.Names {
+			if name.Name == "_" {
+				continue
+			}
+
+			fd := field{
+				Name:    name.Name,
+				Method:  exported(name.Name),
+				Type:    typ,
+				Ordered: isOrdered,
+			}
+
+			if other, ok := methods[fd.Method]; ok {
+				return nil, fmt.Errorf("%w: %s.%s and %s.%s", ErrNameConflict, typeName, other, typeName, fd.Name)
+			}
+			methods[fd.Method] = fd.Name
+
+			s.Fields = append(s.Fields, fd)
+			s.Sorts = s.Sorts || fd.Ordered
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, s); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// typeCheck type checks file on its own, so that the types of the struct's
+// fields can be inspected. Errors are ignored, as types declared in other files
+// of the package can't be resolved; they are left as invalid types.
+func typeCheck(fset *token.FileSet, file *ast.File) *types.Info {
+	info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue)}
+	conf := types.Config{
+		Importer: importer.Default(),
+		Error:    func(err error) {},
+	}
+	conf.Check(file.Name.Name, fset, []*ast.File{file}, info)
+
+	return info
+}
+
+// fieldType reports whether the field type expr is comparable, and whether it
+// is ordered, so can be sorted using <. Where the type couldn't be resolved, it
+// falls back to the field's syntax.
+func fieldType(expr ast.Expr, info *types.Info) (comparable bool, isOrdered bool) {
+	typ := info.TypeOf(expr)
+	if typ == nil || typ == types.Typ[types.Invalid] {
+		switch t := expr.(type) {
+		case *ast.ArrayType:
+			return t.Len != nil, false
+		case *ast.MapType, *ast.FuncType:
+			return false, false
+		}
+
+		return true, ordered[types.ExprString(expr)]
+	}
+
+	basic, ok := typ.Underlying().(*types.Basic)
+	isOrdered = ok && basic.Info()&types.IsOrdered != 0
+
+	return types.Comparable(typ), isOrdered
+}
+
+// findStruct returns the struct type declared in file with the given name, or
+// nil if there isn't one.
+func findStruct(file *ast.File, name string) *ast.StructType {
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+
+		for _, sp := range gd.Specs {
+			ts := sp.(*ast.TypeSpec)
+			if st, ok := ts.Type.(*ast.StructType); ok && ts.Name.Name == name {
+				return st
+			}
+		}
+	}
+
+	return nil
+}
+
+// exported returns name with its first letter in upper case.
+func exported(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+
+	return string(r)
+}
+
+var tmpl = template.Must(template.New("collection").Parse(`// Code generated by collectiongen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	{{- if .Sorts}}
+	"sort"
+	{{end}}
+	"github.com/gostalt/collection"
+)
+
+{{$t := .Type}}{{$c := printf "%sCollection" .Type}}
+// {{$c}} is a collection of {{$t}} items.
+type {{$c}} struct {
+	collection.Collection[{{$t}}]
+}
+
+// New{{$c}} returns a new {{$c}} from the provided slice.
+func New{{$c}}(items []{{$t}}) {{$c}} {
+	return {{$c}}{collection.From(items)}
+}
+{{range .Fields}}
+// {{.Method}}Values returns the {{.Name}} field of every item in the collection.
+func (c {{$c}}) {{.Method}}Values() []{{.Type}} {
+	values := make([]{{.Type}}, c.Count())
+	for i, v := range c.All() {
+		values[i] = v.{{.Name}}
+	}
+
+	return values
+}
+
+// Where{{.Method}} returns the items whose {{.Name}} field is equal to value.
+func (c {{$c}}) Where{{.Method}}(value {{.Type}}) {{$c}} {
+	return {{$c}}{c.Filter(func(i int, v {{$t}}) bool {
+		return v.{{.Name}} == value
+	})}
+}
+{{if .Ordered}}
+// SortBy{{.Method}} returns a copy of the collection sorted by the {{.Name}}
+// field, keeping items with equal values in their original order.
+func (c {{$c}}) SortBy{{.Method}}() {{$c}} {
+	sorted := c.Clone()
+	items := sorted.All()
+	sort.SliceStable(items, func(i int, j int) bool {
+		return items[i].{{.Name}} < items[j].{{.Name}}
+	})
+
+	return {{$c}}{sorted}
+}
+{{end}}{{end}}`))
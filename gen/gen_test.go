@@ -0,0 +1,126 @@
+package gen_test
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"testing"
+
+	"github.com/gostalt/collection/gen"
+	"github.com/stretchr/testify/assert"
+)
+
+const src = `package users
+
+type User struct {
+	ID          int
+	name        string
+	Active      bool
+	Tags        [2]string
+	Manager     *User
+}
+
+type Group struct {
+	Members []User
+}
+
+type Labels []string
+
+type Post struct {
+	Labels Labels
+}
+
+type Status string
+
+type Account struct {
+	Status Status
+	name   string
+	Name   string
+}
+
+type Event struct {
+	Status Status
+	Count  uint
+}
+`
+
+// typeCheck type checks the generated code alongside the source it was
+// generated from, importing packages such as collection from source.
+func typeCheck(t *testing.T, code []byte) error {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, f := range [][]byte{[]byte(src), code} {
+		file, err := parser.ParseFile(fset, "", f, 0)
+		if !assert.NoError(t, err) {
+			return err
+		}
+		files = append(files, file)
+	}
+
+	imp := importer.ForCompiler(fset, "source", nil).(types.ImporterFrom)
+	conf := types.Config{Importer: importerFrom{imp, wd}}
+	_, err = conf.Check("users", fset, files, nil)
+
+	return err
+}
+
+// importerFrom imports packages relative to dir, so that packages are found
+// within the module.
+type importerFrom struct {
+	types.ImporterFrom
+	dir string
+}
+
+func (i importerFrom) Import(path string) (*types.Package, error) {
+	return i.ImportFrom(path, i.dir, 0)
+}
+
+func TestGenerate(t *testing.T) {
+	code, err := gen.Generate([]byte(src), "User")
+	assert.NoError(t, err)
+	assert.NoError(t, typeCheck(t, code))
+
+	out := string(code)
+	assert.Contains(t, out, "package users")
+	assert.Contains(t, out, "type UserCollection struct {\n\tcollection.Collection[User]\n}")
+	assert.Contains(t, out, "func NewUserCollection(items []User) UserCollection {")
+	assert.Contains(t, out, "func (c UserCollection) IDValues() []int {")
+	assert.Contains(t, out, "func (c UserCollection) NameValues() []string {")
+	assert.Contains(t, out, "func (c UserCollection) WhereManager(value *User) UserCollection {")
+	assert.Contains(t, out, "func (c UserCollection) SortByName() UserCollection {")
+	assert.Contains(t, out, "return items[i].name < items[j].name")
+	assert.NotContains(t, out, "SortByActive")
+	assert.NotContains(t, out, "SortByTags")
+}
+
+func TestGenerateNamedTypes(t *testing.T) {
+	code, err := gen.Generate([]byte(src), "Event")
+	assert.NoError(t, err)
+	assert.NoError(t, typeCheck(t, code))
+
+	out := string(code)
+	assert.Contains(t, out, "func (c EventCollection) SortByStatus() EventCollection {")
+	assert.Contains(t, out, "func (c EventCollection) SortByCount() EventCollection {")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	_, err := gen.Generate([]byte(src), "Missing")
+	assert.ErrorIs(t, err, gen.ErrTypeNotFound)
+
+	_, err = gen.Generate([]byte(src), "Group")
+	assert.ErrorIs(t, err, gen.ErrNotComparable)
+
+	_, err = gen.Generate([]byte(src), "Post")
+	assert.ErrorIs(t, err, gen.ErrNotComparable)
+
+	_, err = gen.Generate([]byte(src), "Account")
+	assert.ErrorIs(t, err, gen.ErrNameConflict)
+}
@@ -0,0 +1,70 @@
+package collection
+
+// AdjacencyMap converts a collection of directed edges, each pair leading from
+// its Key to its Value, into a map from each node to the nodes its edges lead
+// to. Neighbours are listed in the order their edges appear.
+func AdjacencyMap[A comparable](edges Collection[Pair[A, A]]) map[A]Collection[A] {
+	adjacent := make(map[A][]A)
+	for _, e := range edges.All() {
+		adjacent[e.Key] = append(adjacent[e.Key], e.Value)
+	}
+
+	m := make(map[A]Collection[A], len(adjacent))
+	for node, neighbours := range adjacent {
+		m[node] = From(neighbours)
+	}
+
+	return m
+}
+
+// ReachableFrom returns every node that can be reached from start by following
+// the given directed edges, including start itself. Nodes are returned in
+// breadth-first order.
+func ReachableFrom[A comparable](edges Collection[Pair[A, A]], start A) Collection[A] {
+	return From(breadthFirst(AdjacencyMap(edges), start, map[A]struct{}{}))
+}
+
+// ConnectedComponents groups the nodes of the given edges into connected
+// components, treating each edge as undirected. Components are ordered by the
+// first appearance of any of their nodes in the edges, and nodes within each
+// component are in breadth-first order.
+func ConnectedComponents[A comparable](edges Collection[Pair[A, A]]) []Collection[A] {
+	undirected := make([]Pair[A, A], 0, edges.Count()*2)
+	for _, e := range edges.All() {
+		undirected = append(undirected, e, Pair[A, A]{Key: e.Value, Value: e.Key})
+	}
+
+	adjacent := AdjacencyMap(From(undirected))
+	visited := make(map[A]struct{}, len(adjacent))
+	components := []Collection[A]{}
+
+	for _, e := range undirected {
+		if _, done := visited[e.Key]; done {
+			continue
+		}
+
+		components = append(components, From(breadthFirst(adjacent, e.Key, visited)))
+	}
+
+	return components
+}
+
+// breadthFirst returns the nodes reachable from start that are not already in
+// visited, in breadth-first order, adding each of them to visited.
+func breadthFirst[A comparable](adjacent map[A]Collection[A], start A, visited map[A]struct{}) []A {
+	visited[start] = struct{}{}
+	order := []A{start}
+
+	for i := 0; i < len(order); i++ {
+		for _, next := range adjacent[order[i]].All() {
+			if _, seen := visited[next]; seen {
+				continue
+			}
+
+			visited[next] = struct{}{}
+			order = append(order, next)
+		}
+	}
+
+	return order
+}
@@ -0,0 +1,44 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func edges(pairs ...[2]string) collection.Collection[collection.Pair[string, string]] {
+	c := collection.Make[collection.Pair[string, string]]()
+	for _, p := range pairs {
+		c = c.Append(collection.Pair[string, string]{Key: p[0], Value: p[1]})
+	}
+
+	return c
+}
+
+func TestAdjacencyMap(t *testing.T) {
+	m := collection.AdjacencyMap(edges([2]string{"a", "b"}, [2]string{"a", "c"}, [2]string{"b", "c"}))
+
+	assert.Len(t, m, 2)
+	assert.Equal(t, []string{"b", "c"}, m["a"].All())
+	assert.Equal(t, []string{"c"}, m["b"].All())
+}
+
+func TestReachableFrom(t *testing.T) {
+	graph := edges([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}, [2]string{"d", "a"})
+
+	assert.Equal(t, []string{"a", "b", "c"}, collection.ReachableFrom(graph, "a").All())
+	assert.Equal(t, []string{"d", "a", "b", "c"}, collection.ReachableFrom(graph, "d").All())
+	assert.Equal(t, []string{"z"}, collection.ReachableFrom(graph, "z").All())
+}
+
+func TestConnectedComponents(t *testing.T) {
+	graph := edges([2]string{"a", "b"}, [2]string{"x", "y"}, [2]string{"c", "b"}, [2]string{"z", "z"})
+
+	components := collection.ConnectedComponents(graph)
+
+	assert.Len(t, components, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, components[0].All())
+	assert.Equal(t, []string{"x", "y"}, components[1].All())
+	assert.Equal(t, []string{"z"}, components[2].All())
+}
@@ -0,0 +1,45 @@
+package collection
+
+// GroupBy groups the items of the collection by the key returned by the given
+// func. Items within each group keep their original relative order. To keep
+// the groups in a keyed collection rather than a map, use dict.GroupBy.
+func GroupBy[T comparable, K comparable](c Collection[T], key func(v T) K) map[K]Collection[T] {
+	groups := make(map[K][]T)
+	for _, v := range c.All() {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+
+	grouped := make(map[K]Collection[T], len(groups))
+	for k, values := range groups {
+		grouped[k] = From(values)
+	}
+
+	return grouped
+}
+
+// GroupConsecutive breaks the collection into groups of neighbouring items that
+// are equal to each other. For example, [a, a, b, a] is grouped into [a, a],
+// [b] and [a].
+func (c Collection[T]) GroupConsecutive() []Collection[T] {
+	return c.ChunkWhile(func(prev T, next T) bool {
+		return prev == next
+	})
+}
+
+// RunLengthEncode compresses runs of equal neighbouring items into pairs of the
+// item and the length of its run. For example, [a, a, b, a] is encoded as
+// (a, 2), (b, 1) and (a, 1).
+//
+// RunLengthEncode is a function rather than a method, as a method on
+// Collection[T] can't return a collection of another type built from T.
+func RunLengthEncode[T comparable](c Collection[T]) Collection[Pair[T, int]] {
+	groups := c.GroupConsecutive()
+
+	runs := make([]Pair[T, int], len(groups))
+	for i, group := range groups {
+		runs[i] = Pair[T, int]{Key: group.First(), Value: group.Count()}
+	}
+
+	return From(runs)
+}
@@ -0,0 +1,37 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBy(t *testing.T) {
+	groups := collection.GroupBy(collection.FromRange(1, 7).Collection, func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+
+	assert.Len(t, groups, 2)
+	assert.Equal(t, []int{2, 4, 6}, groups["even"].All())
+	assert.Equal(t, []int{1, 3, 5, 7}, groups["odd"].All())
+}
+
+func TestGroupConsecutive(t *testing.T) {
+	groups := collection.From([]string{"a", "a", "b", "a"}).GroupConsecutive()
+
+	assert.Len(t, groups, 3)
+	assert.Equal(t, []string{"a", "a"}, groups[0].All())
+	assert.Equal(t, []string{"b"}, groups[1].All())
+	assert.Equal(t, []string{"a"}, groups[2].All())
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	runs := collection.RunLengthEncode(collection.From([]int{0, 0, 0, 1, 0, 0}))
+
+	assert.Equal(t, []collection.Pair[int, int]{{0, 3}, {1, 1}, {0, 2}}, runs.All())
+	assert.True(t, collection.RunLengthEncode(collection.Make[int]()).Empty())
+}
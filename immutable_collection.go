@@ -0,0 +1,136 @@
+package collection
+
+// ImmutableCollection is a collection whose operations always return a new
+// collection, leaving the original untouched. Its underlying slice is never
+// shared with any other collection or caller, so it can't be changed through an
+// alias.
+type ImmutableCollection[T comparable] struct {
+	c Collection[T]
+}
+
+// FromImmutable creates a new ImmutableCollection from a copy of the provided
+// slice.
+func FromImmutable[T comparable](slice []T) ImmutableCollection[T] {
+	return ImmutableCollection[T]{FromCopy(slice)}
+}
+
+// Immutable returns an ImmutableCollection containing a copy of the collection's
+// items.
+func (c Collection[T]) Immutable() ImmutableCollection[T] {
+	return FromImmutable(c.All())
+}
+
+// Mutable returns a MutableCollection containing a copy of the collection's
+// items.
+func (c ImmutableCollection[T]) Mutable() MutableCollection[T] {
+	return FromMutable(c.c.All())
+}
+
+// Collection returns a Collection containing a copy of the collection's items.
+func (c ImmutableCollection[T]) Collection() Collection[T] {
+	return c.c.Clone()
+}
+
+// All returns a copy of the collection's items.
+func (c ImmutableCollection[T]) All() []T {
+	return c.c.Clone().All()
+}
+
+// Count returns the total length of the collection.
+func (c ImmutableCollection[T]) Count() int {
+	return c.c.Count()
+}
+
+// Empty returns true if the collection contains no items.
+func (c ImmutableCollection[T]) Empty() bool {
+	return c.c.Empty()
+}
+
+// At returns the item at the given index. If the index does not exist in the
+// collection, a zero value is returned.
+func (c ImmutableCollection[T]) At(i int) T {
+	return c.c.At(i)
+}
+
+// SafeAt returns the item at the given index. If the index does not exist in the
+// collection, a zero value is returned along with an *IndexError wrapping
+// collection.ErrNoItem.
+func (c ImmutableCollection[T]) SafeAt(i int) (T, error) {
+	return c.c.SafeAt(i)
+}
+
+// First returns the first item in the collection. If the collection is empty, a
+// zero value is returned.
+func (c ImmutableCollection[T]) First() T {
+	return c.c.First()
+}
+
+// Last returns the last item in the collection. If the collection is empty, a
+// zero value is returned.
+func (c ImmutableCollection[T]) Last() T {
+	return c.c.Last()
+}
+
+// Each iterates over each item inside the collection and passes the index and
+// value to the provided func.
+func (c ImmutableCollection[T]) Each(fn func(i int, value T)) {
+	c.c.Each(fn)
+}
+
+// Append returns a new collection with the given values added to the end.
+func (c ImmutableCollection[T]) Append(value ...T) ImmutableCollection[T] {
+	contents := make([]T, c.Count(), c.Count()+len(value))
+	copy(contents, c.c.All())
+
+	return ImmutableCollection[T]{From(append(contents, value...))}
+}
+
+// Prepend returns a new collection with the given values added to the start.
+func (c ImmutableCollection[T]) Prepend(value ...T) ImmutableCollection[T] {
+	return FromImmutable(value).Append(c.c.All()...)
+}
+
+// Concat returns a new collection with the given collection's values added to
+// the end.
+func (c ImmutableCollection[T]) Concat(val ImmutableCollection[T]) ImmutableCollection[T] {
+	return c.Append(val.c.All()...)
+}
+
+// Set returns a new collection with the value at the given index replaced. If
+// the given index is out of range, the new collection is expanded to allow the
+// value to be set.
+func (c ImmutableCollection[T]) Set(index int, value T) ImmutableCollection[T] {
+	new := c.c.Clone()
+	new.Set(index, value)
+
+	return ImmutableCollection[T]{new}
+}
+
+// Pop returns a new collection with count items removed from the end, along with
+// a collection of the removed items.
+func (c ImmutableCollection[T]) Pop(count int) (ImmutableCollection[T], ImmutableCollection[T]) {
+	split := c.c.Split(c.Count() - count)
+
+	return ImmutableCollection[T]{split[0].Clone()}, ImmutableCollection[T]{split[1].Clone()}
+}
+
+// Filter returns a new collection containing only the items for which the
+// predicate returns true.
+func (c ImmutableCollection[T]) Filter(predicate func(i int, v T) bool) ImmutableCollection[T] {
+	return ImmutableCollection[T]{c.c.Filter(predicate)}
+}
+
+// Map returns a new collection containing each item transformed by fn.
+func (c ImmutableCollection[T]) Map(fn func(i int, value T) T) ImmutableCollection[T] {
+	return ImmutableCollection[T]{c.c.Map(fn)}
+}
+
+// Reverse returns a new collection with the values in reverse order.
+func (c ImmutableCollection[T]) Reverse() ImmutableCollection[T] {
+	return ImmutableCollection[T]{c.c.Reverse()}
+}
+
+// Unique returns a new collection containing the unique items.
+func (c ImmutableCollection[T]) Unique() ImmutableCollection[T] {
+	return ImmutableCollection[T]{c.c.Unique()}
+}
@@ -0,0 +1,47 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImmutableCollectionNeverSharesItsSlice(t *testing.T) {
+	orig := []int{1, 2, 3}
+	col := collection.FromImmutable(orig)
+	orig[0] = 5
+
+	all := col.All()
+	all[1] = 5
+
+	assert.Equal(t, []int{1, 2, 3}, col.All())
+}
+
+func TestImmutableCollectionOperationsReturnNewCollections(t *testing.T) {
+	col := collection.MakeWithCapacity[int](10).Append(1, 2, 3).Immutable()
+
+	first := col.Append(4)
+	second := col.Append(5)
+	set := col.Set(0, 9)
+	rest, popped := col.Pop(1)
+
+	assert.Equal(t, []int{1, 2, 3}, col.All())
+	assert.Equal(t, []int{1, 2, 3, 4}, first.All())
+	assert.Equal(t, []int{1, 2, 3, 5}, second.All())
+	assert.Equal(t, []int{9, 2, 3}, set.All())
+	assert.Equal(t, []int{1, 2}, rest.All())
+	assert.Equal(t, []int{3}, popped.All())
+	assert.Equal(t, []int{0, 1, 2, 3}, col.Prepend(0).All())
+	assert.Equal(t, []int{3, 2, 1}, col.Reverse().All())
+}
+
+func TestImmutableToMutable(t *testing.T) {
+	imm := collection.FromImmutable([]int{1, 2, 3})
+	mut := imm.Mutable()
+	mut.Set(0, 5)
+
+	assert.Equal(t, []int{1, 2, 3}, imm.All())
+	assert.Equal(t, []int{5, 2, 3}, mut.All())
+	assert.Equal(t, []int{1, 2, 3}, imm.Collection().All())
+}
@@ -0,0 +1,110 @@
+package collection
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Interner stores a single copy of each distinct string it is given, so that
+// equal strings can share the same memory. It is safe for concurrent use, and
+// can be shared by many collections.
+//
+// An Interner never forgets a string, so it only saves memory where there are
+// few distinct strings. Its strings are freed once the Interner, and every
+// collection using it, is no longer referenced.
+type Interner struct {
+	mu      sync.Mutex
+	strings map[string]string
+}
+
+// NewInterner returns a new empty Interner.
+func NewInterner() *Interner {
+	return &Interner{strings: make(map[string]string)}
+}
+
+// Intern returns the Interner's copy of s, storing s as that copy if the
+// Interner hasn't seen an equal string before.
+func (in *Interner) Intern(s string) string {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	if interned, ok := in.strings[s]; ok {
+		return interned
+	}
+
+	in.strings[s] = s
+	return s
+}
+
+// Len returns the number of distinct strings held by the Interner.
+func (in *Interner) Len() int {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	return len(in.strings)
+}
+
+// Intern enables interning mode for a collection of strings. Every item in the
+// collection is replaced with the Interner's copy of it, and so are the items
+// later added by Append, Set and SafeSet, so collections holding many copies
+// of the same few strings, such as log levels or field names, use far less
+// memory. Interning mode is kept by collections returned by Append.
+//
+// Types defined as a string, such as type ID string, are interned too. Items
+// of any other type are left as they are.
+func (c *Collection[T]) Intern(in *Interner) {
+	c.interner = in
+	for i, v := range c.contents {
+		c.contents[i] = c.internValue(v)
+	}
+}
+
+// internValue returns the interned copy of v if the collection is in interning
+// mode and v is a string, or a type defined as a string.
+func (c Collection[T]) internValue(v T) T {
+	if c.interner == nil {
+		return v
+	}
+
+	if s, ok := any(v).(string); ok {
+		return any(c.interner.Intern(s)).(T)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.String {
+		interned := reflect.ValueOf(c.interner.Intern(rv.String()))
+		return interned.Convert(rv.Type()).Interface().(T)
+	}
+
+	return v
+}
+
+// internValues returns a copy of values with each value interned, if the
+// collection is in interning mode.
+func (c Collection[T]) internValues(values []T) []T {
+	if c.interner == nil {
+		return values
+	}
+
+	interned := make([]T, len(values))
+	for i, v := range values {
+		interned[i] = c.internValue(v)
+	}
+
+	return interned
+}
+
+// InternStrings converts each item in a collection of fmt.Stringers into a
+// string, interning the result with in.
+func InternStrings[T interface {
+	comparable
+	fmt.Stringer
+}](c Collection[T], in *Interner) Collection[string] {
+	strs := make([]string, c.Count())
+	for i, v := range c.contents {
+		strs[i] = in.Intern(v.String())
+	}
+
+	return From(strs)
+}
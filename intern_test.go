@@ -0,0 +1,64 @@
+package collection_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+// sameString returns true if a and b share the same underlying bytes.
+func sameString[S ~string](a S, b S) bool {
+	return len(a) == len(b) && unsafe.StringData(string(a)) == unsafe.StringData(string(b))
+}
+
+func TestIntern(t *testing.T) {
+	in := collection.NewInterner()
+
+	col := collection.From([]string{strings.Repeat("x", 3), strings.Repeat("x", 3)})
+	assert.False(t, sameString(col.At(0), col.At(1)))
+
+	col.Intern(in)
+	assert.True(t, sameString(col.At(0), col.At(1)))
+
+	col = col.Append(strings.Repeat("x", 3), "y")
+	assert.True(t, sameString(col.At(0), col.At(2)))
+
+	col.Set(3, strings.Repeat("x", 3))
+	assert.True(t, sameString(col.At(0), col.At(3)))
+
+	assert.Equal(t, []string{"xxx", "xxx", "xxx", "xxx"}, col.All())
+	assert.Equal(t, 2, in.Len())
+}
+
+type id string
+
+func TestInternNamedStrings(t *testing.T) {
+	in := collection.NewInterner()
+
+	col := collection.From([]id{id(strings.Repeat("x", 3)), id(strings.Repeat("x", 3))})
+	col.Intern(in)
+	assert.True(t, sameString(col.At(0), col.At(1)))
+
+	col = col.Append(id(strings.Repeat("x", 3)))
+	assert.True(t, sameString(col.At(0), col.At(2)))
+	assert.Equal(t, 1, in.Len())
+}
+
+type level int
+
+func (l level) String() string {
+	return "level " + strconv.Itoa(int(l))
+}
+
+func TestInternStrings(t *testing.T) {
+	in := collection.NewInterner()
+
+	strs := collection.InternStrings(collection.From([]level{1, 2, 1}), in)
+
+	assert.Equal(t, []string{"level 1", "level 2", "level 1"}, strs.All())
+	assert.True(t, sameString(strs.At(0), strs.At(2)))
+}
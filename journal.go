@@ -0,0 +1,254 @@
+package collection
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Codec encodes and decodes the items of a collection for persistence.
+type Codec[T any] interface {
+	Encode(v T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+// JSONCodec is a Codec that encodes items as JSON.
+type JSONCodec[T any] struct{}
+
+// Encode encodes v as JSON.
+func (JSONCodec[T]) Encode(v T) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Decode decodes an item from JSON.
+func (JSONCodec[T]) Decode(data []byte) (T, error) {
+	var v T
+	err := json.Unmarshal(data, &v)
+
+	return v, err
+}
+
+const (
+	journalAppend   = "append"
+	journalSet      = "set"
+	journalPop      = "pop"
+	journalRemoveAt = "remove"
+)
+
+// Journal is a collection that records every change made to it in an
+// append-only journal file, so that it can be rebuilt when the journal is next
+// opened.
+type Journal[T comparable] struct {
+	c     Collection[T]
+	file  *os.File
+	codec Codec[T]
+}
+
+// OpenJournal opens the journal file at the given path, creating it if it
+// doesn't exist, and rebuilds the collection by replaying every change recorded
+// in it. An error wrapping collection.ErrCorruptJournal is returned if the
+// journal can't be replayed. A final, partially written entry, such as one left
+// by a crash, is ignored.
+func OpenJournal[T comparable](path string, codec Codec[T]) (*Journal[T], error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &Journal[T]{c: Make[T](), file: file, codec: codec}
+	if err := j.replay(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// replay rebuilds the collection from the entries in the journal file.
+func (j *Journal[T]) replay() error {
+	r := bufio.NewReader(j.file)
+	var offset int64
+
+	for line := 1; ; line++ {
+		entry, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// Discard any partially written entry, so that new entries are
+			// not appended to it.
+			if len(entry) > 0 {
+				return j.file.Truncate(offset)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		offset += int64(len(entry))
+
+		if err := j.apply(bytes.TrimSuffix(entry, []byte("\n"))); err != nil {
+			return fmt.Errorf("%w: line %d: %v", ErrCorruptJournal, line, err)
+		}
+	}
+}
+
+// apply applies a single journal entry to the collection.
+func (j *Journal[T]) apply(entry []byte) error {
+	return applyEntry(&j.c, j.codec, entry)
+}
+
+// write records a single change in the journal file.
+func (j *Journal[T]) write(op string, n int, value *T) error {
+	entry, err := encodeEntry(j.codec, op, n, value)
+	if err != nil {
+		return err
+	}
+
+	_, err = j.file.Write(entry)
+
+	return err
+}
+
+// encodeEntry encodes a single change as a journal entry. Each entry is made up
+// of an operation, an index or count, and an optional base64 encoded value,
+// separated by tabs and terminated by a newline.
+func encodeEntry[T comparable](codec Codec[T], op string, n int, value *T) ([]byte, error) {
+	encoded := ""
+	if value != nil {
+		data, err := codec.Encode(*value)
+		if err != nil {
+			return nil, err
+		}
+		encoded = base64.StdEncoding.EncodeToString(data)
+	}
+
+	return []byte(fmt.Sprintf("%s\t%d\t%s\n", op, n, encoded)), nil
+}
+
+// applyEntry applies a single journal entry, without its trailing newline, to
+// the given collection.
+func applyEntry[T comparable](c *Collection[T], codec Codec[T], entry []byte) error {
+	fields := bytes.Split(entry, []byte("\t"))
+	if len(fields) != 3 {
+		return fmt.Errorf("expected 3 fields, got %d", len(fields))
+	}
+
+	n, err := strconv.Atoi(string(fields[1]))
+	if err != nil {
+		return err
+	}
+
+	var value T
+	if len(fields[2]) > 0 {
+		data, err := base64.StdEncoding.DecodeString(string(fields[2]))
+		if err != nil {
+			return err
+		}
+
+		if value, err = codec.Decode(data); err != nil {
+			return err
+		}
+	}
+
+	switch string(fields[0]) {
+	case journalAppend:
+		*c = c.Append(value)
+	case journalSet:
+		// Set can grow the collection, but only by one item at a time, so a
+		// corrupt index can't cause a huge allocation.
+		if n < 0 || n > c.Count() {
+			return &IndexError{Index: n, Len: c.Count(), Err: ErrIndexOutOfRange}
+		}
+		c.Set(n, value)
+	case journalPop:
+		if n < 0 || n > c.Count() {
+			return fmt.Errorf("cannot pop %d items from %d", n, c.Count())
+		}
+		c.Pop(n)
+	case journalRemoveAt:
+		if *c, err = c.SafeRemoveAt(n); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", fields[0])
+	}
+
+	return nil
+}
+
+// Collection returns the journal's current items.
+func (j *Journal[T]) Collection() Collection[T] {
+	return j.c
+}
+
+// Append adds the given values to the end of the collection.
+func (j *Journal[T]) Append(values ...T) error {
+	for _, v := range values {
+		if err := j.write(journalAppend, 0, &v); err != nil {
+			return err
+		}
+		j.c = j.c.Append(v)
+	}
+
+	return nil
+}
+
+// Set updates the value at the given index to value. If the index is the
+// collection's length, the value is appended. If the index is negative or
+// beyond the end of the collection, a *collection.IndexError is returned and
+// nothing is written to the journal.
+func (j *Journal[T]) Set(index int, value T) error {
+	if index < 0 || index > j.c.Count() {
+		return &IndexError{Index: index, Len: j.c.Count(), Err: ErrIndexOutOfRange}
+	}
+
+	if err := j.write(journalSet, index, &value); err != nil {
+		return err
+	}
+	j.c.Set(index, value)
+
+	return nil
+}
+
+// Pop removes and returns items from the end of the collection. If there are
+// fewer than count items, an *IndexError wrapping collection.ErrIndexOutOfRange
+// is returned.
+func (j *Journal[T]) Pop(count int) (Collection[T], error) {
+	if count < 0 || count > j.c.Count() {
+		return Make[T](), &IndexError{Index: count, Len: j.c.Count(), Err: ErrIndexOutOfRange}
+	}
+
+	if err := j.write(journalPop, count, nil); err != nil {
+		return Make[T](), err
+	}
+
+	return j.c.Pop(count), nil
+}
+
+// RemoveAt removes the item at the given index. If the index does not exist in
+// the collection, collection.ErrIndexOutOfRange is returned.
+func (j *Journal[T]) RemoveAt(index int) error {
+	if _, err := j.c.SafeRemoveAt(index); err != nil {
+		return err
+	}
+
+	if err := j.write(journalRemoveAt, index, nil); err != nil {
+		return err
+	}
+	j.c = j.c.RemoveAt(index)
+
+	return nil
+}
+
+// Sync commits the journal file to stable storage.
+func (j *Journal[T]) Sync() error {
+	return j.file.Sync()
+}
+
+// Close closes the journal file.
+func (j *Journal[T]) Close() error {
+	return j.file.Close()
+}
@@ -0,0 +1,89 @@
+package collection_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournalRebuildsCollection(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.journal")
+
+	j, err := collection.OpenJournal[string](path, collection.JSONCodec[string]{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, j.Append("a", "b\nwith newline", "c", "d"))
+	assert.NoError(t, j.Set(0, "z"))
+	assert.NoError(t, j.RemoveAt(2))
+	popped, err := j.Pop(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d"}, popped.All())
+	assert.Equal(t, []string{"z", "b\nwith newline"}, j.Collection().All())
+	assert.NoError(t, j.Close())
+
+	reopened, err := collection.OpenJournal[string](path, collection.JSONCodec[string]{})
+	assert.NoError(t, err)
+	defer reopened.Close()
+
+	assert.Equal(t, []string{"z", "b\nwith newline"}, reopened.Collection().All())
+}
+
+func TestJournalRejectsInvalidChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "j")
+
+	j, err := collection.OpenJournal[int](path, collection.JSONCodec[int]{})
+	assert.NoError(t, err)
+	defer j.Close()
+
+	_, err = j.Pop(1)
+	var indexErr *collection.IndexError
+	assert.ErrorAs(t, err, &indexErr)
+	assert.Equal(t, 1, indexErr.Index)
+	assert.ErrorIs(t, err, collection.ErrIndexOutOfRange)
+	assert.ErrorIs(t, j.RemoveAt(0), collection.ErrIndexOutOfRange)
+	assert.ErrorIs(t, j.Set(-1, 1), collection.ErrIndexOutOfRange)
+	assert.ErrorIs(t, j.Set(1<<62, 1), collection.ErrIndexOutOfRange)
+	assert.NoError(t, j.Append(1))
+
+	reopened, err := collection.OpenJournal[int](path, collection.JSONCodec[int]{})
+	assert.NoError(t, err)
+	defer reopened.Close()
+	assert.Equal(t, []int{1}, reopened.Collection().All())
+}
+
+func TestJournalIgnoresPartialEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "j")
+	assert.NoError(t, os.WriteFile(path, []byte("append\t0\tMQ==\nappend\t0\tM"), 0o644))
+
+	j, err := collection.OpenJournal[int](path, collection.JSONCodec[int]{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, j.Collection().All())
+	assert.NoError(t, j.Append(2))
+	assert.NoError(t, j.Close())
+
+	j, err = collection.OpenJournal[int](path, collection.JSONCodec[int]{})
+	assert.NoError(t, err)
+	defer j.Close()
+	assert.Equal(t, []int{1, 2}, j.Collection().All())
+}
+
+func TestJournalCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "j")
+	assert.NoError(t, os.WriteFile(path, []byte("explode\t0\t\n"), 0o644))
+
+	_, err := collection.OpenJournal[int](path, collection.JSONCodec[int]{})
+	assert.ErrorIs(t, err, collection.ErrCorruptJournal)
+
+	assert.NoError(t, os.WriteFile(path, []byte("set\t-1\tMQ==\n"), 0o644))
+
+	_, err = collection.OpenJournal[int](path, collection.JSONCodec[int]{})
+	assert.ErrorIs(t, err, collection.ErrCorruptJournal)
+
+	assert.NoError(t, os.WriteFile(path, []byte("set\t4611686018427387904\tMQ==\n"), 0o644))
+
+	_, err = collection.OpenJournal[int](path, collection.JSONCodec[int]{})
+	assert.ErrorIs(t, err, collection.ErrCorruptJournal)
+}
@@ -0,0 +1,70 @@
+package collection
+
+import (
+	"fmt"
+	"os"
+	"unsafe"
+)
+
+// MappedCollection is a NumericCollection backed by a memory-mapped file.
+// Multiple processes mapping the same file share the same memory.
+//
+// The mapping is copy-on-write: methods that change the collection in place,
+// such as Set or MapInPlace, copy the pages they write to, and the changes are
+// never written back to the file or seen by other processes.
+type MappedCollection[T numeric] struct {
+	NumericCollection[T]
+	data []byte
+}
+
+// MapFile memory-maps the file at the given path as a collection of
+// fixed-width numeric values, stored in the machine's native byte order. The
+// file's size must be a multiple of the size of T. Close must be called once
+// the collection is no longer needed.
+func MapFile[T numeric](path string) (*MappedCollection[T], error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	size := int(unsafe.Sizeof(*new(T)))
+	if info.Size()%int64(size) != 0 {
+		return nil, fmt.Errorf("collection: file size %d is not a multiple of %d", info.Size(), size)
+	}
+
+	if info.Size() == 0 {
+		return &MappedCollection[T]{NumericCollection: FromNumeric([]T{})}, nil
+	}
+
+	data, err := mmap(file, int(info.Size()))
+	if err != nil {
+		return nil, err
+	}
+
+	values := unsafe.Slice((*T)(unsafe.Pointer(&data[0])), len(data)/size)
+
+	return &MappedCollection[T]{
+		NumericCollection: FromNumeric(values[:len(values):len(values)]),
+		data:              data,
+	}, nil
+}
+
+// Close unmaps the file. The collection, and any collection sharing its
+// underlying data, must not be used after Close is called.
+func (c *MappedCollection[T]) Close() error {
+	if c.data == nil {
+		return nil
+	}
+
+	err := munmap(c.data)
+	c.data = nil
+	c.contents = nil
+
+	return err
+}
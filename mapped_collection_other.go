@@ -0,0 +1,18 @@
+//go:build !unix
+
+package collection
+
+import (
+	"errors"
+	"os"
+)
+
+var errMmapUnsupported = errors.New("collection: memory-mapped files are not supported on this platform")
+
+func mmap(file *os.File, size int) ([]byte, error) {
+	return nil, errMmapUnsupported
+}
+
+func munmap(data []byte) error {
+	return errMmapUnsupported
+}
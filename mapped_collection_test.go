@@ -0,0 +1,67 @@
+//go:build unix
+
+package collection_test
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.bin")
+	data := make([]byte, 0, 32)
+	for _, v := range []int64{4, 8, 15, 16} {
+		data = binary.LittleEndian.AppendUint64(data, uint64(v))
+	}
+	assert.NoError(t, os.WriteFile(path, data, 0o644))
+
+	col, err := collection.MapFile[int64](path)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int64{4, 8, 15, 16}, col.All())
+	assert.Equal(t, int64(43), col.Sum())
+	assert.Equal(t, int64(16), col.Max())
+	assert.NoError(t, col.Close())
+}
+
+func TestMapFileRejectsPartialValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.bin")
+	assert.NoError(t, os.WriteFile(path, []byte{1, 2, 3}, 0o644))
+
+	_, err := collection.MapFile[int32](path)
+	assert.Error(t, err)
+}
+
+func TestMapFileEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.bin")
+	assert.NoError(t, os.WriteFile(path, nil, 0o644))
+
+	col, err := collection.MapFile[float64](path)
+	assert.NoError(t, err)
+	assert.True(t, col.Empty())
+	assert.NoError(t, col.Close())
+}
+
+func TestMapFileWritesStayInProcess(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.bin")
+	data := binary.LittleEndian.AppendUint32(nil, 1)
+	data = binary.LittleEndian.AppendUint32(data, 2)
+	assert.NoError(t, os.WriteFile(path, data, 0o644))
+
+	col, err := collection.MapFile[int32](path)
+	assert.NoError(t, err)
+	defer col.Close()
+
+	col.Set(0, 5)
+	col.ReverseInPlace()
+	assert.Equal(t, []int32{2, 5}, col.All())
+
+	onDisk, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, data, onDisk)
+}
@@ -0,0 +1,19 @@
+//go:build unix
+
+package collection
+
+import (
+	"os"
+	"syscall"
+)
+
+// mmap maps the file as a private, copy-on-write mapping. Pages are shared with
+// other processes until they are written to, at which point the writing process
+// gets its own copy, so changes never reach the file.
+func mmap(file *os.File, size int) ([]byte, error) {
+	return syscall.Mmap(int(file.Fd()), 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE)
+}
+
+func munmap(data []byte) error {
+	return syscall.Munmap(data)
+}
@@ -0,0 +1,45 @@
+package collection
+
+// MergeBy merges two collections by key, calling resolve whenever an item has
+// the same key as an earlier one to choose the item to keep. resolve is passed
+// the item kept so far first, so when merging a local cache a with fresh data
+// b, resolve(cached, fresh) is called for each key found in both.
+//
+// Items are returned in the order their key first appears in a, followed by the
+// order their key first appears in b.
+func MergeBy[T comparable, K comparable](a Collection[T], b Collection[T], key func(v T) K, resolve func(a T, b T) T) Collection[T] {
+	positions := make(map[K]int, a.Count()+b.Count())
+	merged := make([]T, 0, a.Count()+b.Count())
+
+	for _, v := range a.Concat(b).All() {
+		k := key(v)
+		i, found := positions[k]
+		if !found {
+			positions[k] = len(merged)
+			merged = append(merged, v)
+			continue
+		}
+
+		merged[i] = resolve(merged[i], v)
+	}
+
+	return From(merged)
+}
+
+// MergeLatest merges two collections by key, keeping whichever item has the
+// higher version for each key. Versions are compared using the given func, which
+// can return any ordered value such as a version number or a timestamp's
+// UnixNano. If two items for the same key have equal versions, the item from a
+// is kept, so that the result is deterministic.
+//
+// Items are returned in the order their key first appears in a, followed by the
+// order their key first appears in b.
+func MergeLatest[T comparable, K comparable, V ordered](a Collection[T], b Collection[T], key func(v T) K, version func(v T) V) Collection[T] {
+	return MergeBy(a, b, key, func(kept T, v T) T {
+		if version(v) > version(kept) {
+			return v
+		}
+
+		return kept
+	})
+}
@@ -0,0 +1,51 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+type record struct {
+	id      string
+	value   string
+	version int
+}
+
+func recordID(v record) string {
+	return v.id
+}
+
+func recordVersion(v record) int {
+	return v.version
+}
+
+func TestMergeLatest(t *testing.T) {
+	local := collection.From([]record{{"a", "old", 1}, {"b", "local", 3}, {"c", "local", 1}})
+	remote := collection.From([]record{{"b", "remote", 2}, {"a", "new", 2}, {"d", "remote", 1}, {"c", "remote", 1}})
+
+	merged := collection.MergeLatest(local, remote, recordID, recordVersion)
+
+	assert.Equal(t, []record{
+		{"a", "new", 2},
+		{"b", "local", 3},
+		{"c", "local", 1},
+		{"d", "remote", 1},
+	}, merged.All())
+}
+
+func TestMergeBy(t *testing.T) {
+	cached := collection.From([]record{{"a", "cached", 1}, {"b", "cached", 1}})
+	fresh := collection.From([]record{{"b", "fresh", 2}, {"c", "fresh", 1}})
+
+	merged := collection.MergeBy(cached, fresh, recordID, func(a record, b record) record {
+		return record{a.id, a.value + "+" + b.value, b.version}
+	})
+
+	assert.Equal(t, []record{
+		{"a", "cached", 1},
+		{"b", "cached+fresh", 2},
+		{"c", "fresh", 1},
+	}, merged.All())
+}
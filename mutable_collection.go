@@ -0,0 +1,199 @@
+package collection
+
+// MutableCollection is a collection whose operations always change the
+// collection in place, rather than returning a new collection.
+type MutableCollection[T comparable] struct {
+	c Collection[T]
+
+	onAppend []func(values []T)
+	onSet    []func(index int, old T, new T)
+	onPop    []func(values []T)
+	onChange []func()
+}
+
+// FromMutable creates a new MutableCollection from a copy of the provided slice.
+func FromMutable[T comparable](slice []T) MutableCollection[T] {
+	return MutableCollection[T]{c: FromCopy(slice)}
+}
+
+// Mutable returns a MutableCollection containing a copy of the collection's
+// items. The MutableCollection keeps the collection's bounds mode.
+func (c Collection[T]) Mutable() MutableCollection[T] {
+	m := FromMutable(c.All())
+	m.c.bounds = c.bounds
+
+	return m
+}
+
+// Immutable returns an ImmutableCollection containing a copy of the collection's
+// items.
+func (c *MutableCollection[T]) Immutable() ImmutableCollection[T] {
+	return FromImmutable(c.c.All())
+}
+
+// Collection returns a Collection containing a copy of the collection's items.
+func (c *MutableCollection[T]) Collection() Collection[T] {
+	return c.c.Clone()
+}
+
+// All returns the underlying data for the collection.
+func (c *MutableCollection[T]) All() []T {
+	return c.c.All()
+}
+
+// Count returns the total length of the collection.
+func (c *MutableCollection[T]) Count() int {
+	return c.c.Count()
+}
+
+// Empty returns true if the collection contains no items.
+func (c *MutableCollection[T]) Empty() bool {
+	return c.c.Empty()
+}
+
+// At returns the item at the given index. If the index does not exist in the
+// collection, a zero value is returned.
+func (c *MutableCollection[T]) At(i int) T {
+	return c.c.At(i)
+}
+
+// SafeAt returns the item at the given index. If the index does not exist in the
+// collection, a zero value is returned along with an *IndexError wrapping
+// collection.ErrNoItem.
+func (c *MutableCollection[T]) SafeAt(i int) (T, error) {
+	return c.c.SafeAt(i)
+}
+
+// First returns the first item in the collection. If the collection is empty, a
+// zero value is returned.
+func (c *MutableCollection[T]) First() T {
+	return c.c.First()
+}
+
+// Last returns the last item in the collection. If the collection is empty, a
+// zero value is returned.
+func (c *MutableCollection[T]) Last() T {
+	return c.c.Last()
+}
+
+// Each iterates over each item inside the collection and passes the index and
+// value to the provided func.
+func (c *MutableCollection[T]) Each(fn func(i int, value T)) {
+	c.c.Each(fn)
+}
+
+// OnAppend registers fn to be called with the appended values after each call
+// to Append or Concat.
+func (c *MutableCollection[T]) OnAppend(fn func(values []T)) {
+	c.onAppend = append(c.onAppend, fn)
+}
+
+// OnSet registers fn to be called after each call to Set, with the index that
+// was set and its old and new values. If Set expanded the collection, old is a
+// zero value.
+func (c *MutableCollection[T]) OnSet(fn func(index int, old T, new T)) {
+	c.onSet = append(c.onSet, fn)
+}
+
+// OnPop registers fn to be called with the removed values after each call to
+// Pop.
+func (c *MutableCollection[T]) OnPop(fn func(values []T)) {
+	c.onPop = append(c.onPop, fn)
+}
+
+// OnChange registers fn to be called after every operation that changes the
+// collection, including Prepend, Filter, Map, Reverse and Unique, which have no
+// hook of their own. It is called after any more specific hook.
+func (c *MutableCollection[T]) OnChange(fn func()) {
+	c.onChange = append(c.onChange, fn)
+}
+
+// changed calls each of the OnChange hooks.
+func (c *MutableCollection[T]) changed() {
+	for _, fn := range c.onChange {
+		fn()
+	}
+}
+
+// Append adds the given values to the end of the collection.
+func (c *MutableCollection[T]) Append(value ...T) {
+	c.c = c.c.Append(value...)
+
+	for _, fn := range c.onAppend {
+		fn(value)
+	}
+	c.changed()
+}
+
+// Prepend adds the given values to the start of the collection.
+func (c *MutableCollection[T]) Prepend(value ...T) {
+	bounds := c.c.bounds
+	c.c = FromCopy(value).Append(c.c.All()...)
+	c.c.bounds = bounds
+	c.changed()
+}
+
+// Concat adds the given collection's values to the end of the collection.
+func (c *MutableCollection[T]) Concat(val *MutableCollection[T]) {
+	c.Append(val.c.All()...)
+}
+
+// Set updates the value at the given index to value. If the given index is out
+// of range, the collection is expanded to allow the value to be set.
+func (c *MutableCollection[T]) Set(index int, value T) {
+	// Apply the bounds mode first, so that the hooks are given the index that
+	// was actually set.
+	index = c.c.bound(index, c.c.Count()-1)
+
+	old := c.c.At(index)
+	c.c.Set(index, value)
+
+	for _, fn := range c.onSet {
+		fn(index, old, value)
+	}
+	c.changed()
+}
+
+// Pop removes count items from the end of the collection and returns them.
+func (c *MutableCollection[T]) Pop(count int) MutableCollection[T] {
+	popped := FromMutable(c.c.Pop(count).All())
+
+	for _, fn := range c.onPop {
+		fn(popped.All())
+	}
+	c.changed()
+
+	return popped
+}
+
+// Filter removes the items for which the predicate returns false.
+func (c *MutableCollection[T]) Filter(predicate func(i int, v T) bool) {
+	c.c.FilterInPlace(predicate)
+	c.changed()
+}
+
+// Map replaces each item with the result of passing it to fn.
+func (c *MutableCollection[T]) Map(fn func(i int, value T) T) {
+	c.c.MapInPlace(fn)
+	c.changed()
+}
+
+// Reverse reverses the order of the items in the collection.
+func (c *MutableCollection[T]) Reverse() {
+	c.c.ReverseInPlace()
+	c.changed()
+}
+
+// Unique removes any duplicate items, keeping the first occurrence of each.
+func (c *MutableCollection[T]) Unique() {
+	seen := make(map[T]struct{}, c.Count())
+	c.c.FilterInPlace(func(i int, v T) bool {
+		if _, ok := seen[v]; ok {
+			return false
+		}
+		seen[v] = struct{}{}
+
+		return true
+	})
+	c.changed()
+}
@@ -0,0 +1,117 @@
+package collection_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutableCollectionOperationsChangeInPlace(t *testing.T) {
+	col := collection.FromMutable([]int{3, 1, 2, 3})
+
+	col.Append(4, 5)
+	assert.Equal(t, []int{3, 1, 2, 3, 4, 5}, col.All())
+
+	col.Prepend(0)
+	assert.Equal(t, []int{0, 3, 1, 2, 3, 4, 5}, col.All())
+
+	col.Unique()
+	assert.Equal(t, []int{0, 3, 1, 2, 4, 5}, col.All())
+
+	col.Filter(func(i int, value int) bool {
+		return value > 0
+	})
+	assert.Equal(t, []int{3, 1, 2, 4, 5}, col.All())
+
+	col.Map(func(i int, value int) int {
+		return value * 10
+	})
+	assert.Equal(t, []int{30, 10, 20, 40, 50}, col.All())
+
+	popped := col.Pop(2)
+	assert.Equal(t, []int{30, 10, 20}, col.All())
+	assert.Equal(t, []int{40, 50}, popped.All())
+
+	col.Reverse()
+	assert.Equal(t, []int{20, 10, 30}, col.All())
+}
+
+func TestMutableCollectionHooks(t *testing.T) {
+	col := collection.FromMutable([]string{"a", "b"})
+
+	log := []string{}
+	col.OnAppend(func(values []string) {
+		log = append(log, "append "+strings.Join(values, ","))
+	})
+	col.OnSet(func(index int, old string, new string) {
+		log = append(log, fmt.Sprintf("set %d %q -> %q", index, old, new))
+	})
+	col.OnPop(func(values []string) {
+		log = append(log, "pop "+strings.Join(values, ","))
+	})
+
+	col.Append("c", "d")
+	col.Set(0, "z")
+	col.Set(4, "e")
+	col.Pop(2)
+
+	assert.Equal(t, []string{
+		`append c,d`,
+		`set 0 "a" -> "z"`,
+		`set 4 "" -> "e"`,
+		`pop d,e`,
+	}, log)
+	assert.Equal(t, []string{"z", "b", "c"}, col.All())
+}
+
+func TestMutableCollectionOnChange(t *testing.T) {
+	col := collection.FromMutable([]int{3, 1, 2, 1})
+
+	changes := 0
+	col.OnChange(func() {
+		changes++
+	})
+
+	col.Prepend(4)
+	col.Filter(func(i int, v int) bool {
+		return v != 2
+	})
+	col.Map(func(i int, v int) int {
+		return v * 2
+	})
+	col.Reverse()
+	col.Unique()
+	col.Append(5)
+	col.Set(0, 6)
+	col.Pop(1)
+
+	assert.Equal(t, 8, changes)
+	assert.Equal(t, []int{6, 6, 8}, col.All())
+}
+
+func TestMutableCollectionSetHookWithClamping(t *testing.T) {
+	col := collection.New([]string{"a", "b"}, collection.WithClamping()).Mutable()
+
+	var set []int
+	col.OnSet(func(index int, old string, new string) {
+		set = append(set, index)
+		assert.Equal(t, "b", old)
+	})
+
+	col.Set(10, "c")
+
+	assert.Equal(t, []int{1}, set)
+	assert.Equal(t, []string{"a", "c"}, col.All())
+}
+
+func TestMutableToImmutable(t *testing.T) {
+	mut := collection.From([]int{1, 2, 3}).Mutable()
+	imm := mut.Immutable()
+	mut.Set(0, 5)
+
+	assert.Equal(t, []int{5, 2, 3}, mut.All())
+	assert.Equal(t, []int{1, 2, 3}, imm.All())
+}
@@ -1,17 +1,30 @@
 package collection
 
+import (
+	"math"
+	"sort"
+)
+
 type i interface {
-	int | int8 | int16 | int32 | int64
+	~int | ~int8 | ~int16 | ~int32 | ~int64
 }
 
 type f interface {
-	float32 | float64
+	~float32 | ~float64
 }
 
 type numeric interface {
 	i | f
 }
 
+type u interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+type ordered interface {
+	numeric | u | ~string
+}
+
 type NumericCollection[T numeric] struct {
 	Collection[T]
 }
@@ -80,6 +93,29 @@ func (c NumericCollection[T]) Average64() float64 {
 	return float64(sum) / float64(count)
 }
 
+// Median returns the middle number of the collection once sorted. If the
+// collection has an even number of items, the mean average of the two middle
+// numbers is returned. As with Average, the median of an empty collection is
+// NaN.
+func (c NumericCollection[T]) Median() float64 {
+	if c.Empty() {
+		return math.NaN()
+	}
+
+	sorted := make([]T, c.Count())
+	copy(sorted, c.contents)
+	sort.Slice(sorted, func(i int, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return float64(sorted[mid])
+	}
+
+	return (float64(sorted[mid-1]) + float64(sorted[mid])) / 2
+}
+
 // Min returns the smallest number in the collection. If the collection is empty,
 // a zero value is returned.
 func (c NumericCollection[T]) Min() T {
@@ -116,6 +152,55 @@ func (c NumericCollection[T]) Max() T {
 	return max
 }
 
+// ArgMin returns the index of the smallest number in the collection, or of the
+// first one if there is a tie. If the collection is empty, -1 is returned.
+func (c NumericCollection[T]) ArgMin() int {
+	i, _ := c.SafeArgMin()
+	return i
+}
+
+// SafeArgMin returns the index of the smallest number in the collection, or of
+// the first one if there is a tie. If the collection is empty, -1 and
+// collection.ErrNoItem are returned.
+func (c NumericCollection[T]) SafeArgMin() (int, error) {
+	return c.argBest(func(a T, b T) bool {
+		return a < b
+	})
+}
+
+// ArgMax returns the index of the largest number in the collection, or of the
+// first one if there is a tie. If the collection is empty, -1 is returned.
+func (c NumericCollection[T]) ArgMax() int {
+	i, _ := c.SafeArgMax()
+	return i
+}
+
+// SafeArgMax returns the index of the largest number in the collection, or of
+// the first one if there is a tie. If the collection is empty, -1 and
+// collection.ErrNoItem are returned.
+func (c NumericCollection[T]) SafeArgMax() (int, error) {
+	return c.argBest(func(a T, b T) bool {
+		return a > b
+	})
+}
+
+// argBest returns the index of the first number that is better than every
+// number before it.
+func (c NumericCollection[T]) argBest(better func(a T, b T) bool) (int, error) {
+	if c.Empty() {
+		return -1, ErrNoItem
+	}
+
+	best := 0
+	for i, v := range c.contents {
+		if better(v, c.contents[best]) {
+			best = i
+		}
+	}
+
+	return best, nil
+}
+
 // Sum returns the total value of all of the values inside the collection.
 func (c NumericCollection[T]) Sum() T {
 	var total T = 0
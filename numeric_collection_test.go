@@ -1,6 +1,7 @@
 package collection_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/gostalt/collection"
@@ -18,6 +19,13 @@ func TestAverage(t *testing.T) {
 	assert.Equal(t, 3.5, f64)
 }
 
+func TestMedian(t *testing.T) {
+	assert.Equal(t, 3.0, collection.FromNumeric([]int{5, 1, 3}).Median())
+	assert.Equal(t, 2.5, collection.FromNumeric([]int{4, 1, 3, 2}).Median())
+	assert.Equal(t, 1.5, collection.FromNumeric([]float32{1.5}).Median())
+	assert.True(t, math.IsNaN(collection.FromNumeric([]int{}).Median()))
+}
+
 func TestMin(t *testing.T) {
 	min := collection.FromNumeric([]int{3, 2, 8, 1, 2}).Min()
 
@@ -30,6 +38,23 @@ func TestMax(t *testing.T) {
 	assert.Equal(t, 8, max)
 }
 
+func TestArgMinAndArgMax(t *testing.T) {
+	col := collection.FromNumeric([]float64{3, 1, 4, 1, 5, 9, 2, 9})
+
+	assert.Equal(t, 1, col.ArgMin())
+	assert.Equal(t, 5, col.ArgMax())
+
+	empty := collection.FromNumeric([]int{})
+	assert.Equal(t, -1, empty.ArgMax())
+
+	_, err := empty.SafeArgMin()
+	assert.ErrorIs(t, err, collection.ErrNoItem)
+
+	i, err := col.SafeArgMax()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, i)
+}
+
 func TestSum(t *testing.T) {
 	sum := collection.FromNumeric([]int{1, 2, 3, 4}).Sum()
 
@@ -0,0 +1,50 @@
+package collection
+
+// Page holds a single page of a paginated collection.
+type Page[T comparable] struct {
+	// Items contains the items on the page.
+	Items Collection[T]
+	// Page is the page number, starting from 1.
+	Page int
+	// PerPage is the maximum number of items on each page.
+	PerPage int
+	// Total is the number of items across every page.
+	Total int
+	// Pages is the number of pages.
+	Pages int
+}
+
+// ForPage returns the items on the given page, where each page holds perPage
+// items. Pages are numbered from 1. Pages that are out of range, or a perPage of
+// zero or less, result in an empty collection.
+func (c Collection[T]) ForPage(page int, perPage int) Collection[T] {
+	// page and perPage often come from query strings, so check the page is in
+	// range before multiplying, to rule out overflow.
+	if page < 1 || perPage < 1 || page-1 > c.Count()/perPage {
+		return Make[T]()
+	}
+
+	start := (page - 1) * perPage
+	if start >= c.Count() {
+		return Make[T]()
+	}
+
+	return c.SubSlice(start, start+clamp(perPage, 0, c.Count()-start))
+}
+
+// Paginate returns the given page of items, where each page holds perPage items,
+// along with the total number of items and pages.
+func (c Collection[T]) Paginate(page int, perPage int) Page[T] {
+	pages := 0
+	if perPage > 0 && !c.Empty() {
+		pages = (c.Count()-1)/perPage + 1
+	}
+
+	return Page[T]{
+		Items:   c.ForPage(page, perPage),
+		Page:    page,
+		PerPage: perPage,
+		Total:   c.Count(),
+		Pages:   pages,
+	}
+}
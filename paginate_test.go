@@ -0,0 +1,39 @@
+package collection_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForPage(t *testing.T) {
+	col := collection.FromRange(1, 10)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, col.ForPage(1, 4).All())
+	assert.Equal(t, []int{9, 10}, col.ForPage(3, 4).All())
+	assert.True(t, col.ForPage(4, 4).Empty())
+	assert.True(t, col.ForPage(0, 4).Empty())
+	assert.True(t, col.ForPage(1, 0).Empty())
+	assert.True(t, col.ForPage(1<<61+1, 8).Empty())
+	assert.Equal(t, col.All(), col.ForPage(1, math.MaxInt).All())
+}
+
+func TestPaginate(t *testing.T) {
+	page := collection.FromRange(1, 10).Paginate(2, 4)
+
+	assert.Equal(t, []int{5, 6, 7, 8}, page.Items.All())
+	assert.Equal(t, 2, page.Page)
+	assert.Equal(t, 4, page.PerPage)
+	assert.Equal(t, 10, page.Total)
+	assert.Equal(t, 3, page.Pages)
+
+	empty := collection.Make[int]().Paginate(1, 10)
+	assert.Equal(t, 0, empty.Pages)
+	assert.True(t, empty.Items.Empty())
+
+	single := collection.FromRange(1, 10).Paginate(1, math.MaxInt)
+	assert.Equal(t, 1, single.Pages)
+	assert.Equal(t, 10, single.Items.Count())
+}
@@ -0,0 +1,185 @@
+package collection
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Pair holds two related values, such as a key and its value, or the items at
+// the same index of two zipped collections.
+type Pair[K comparable, V comparable] struct {
+	Key   K
+	Value V
+}
+
+// Zip pairs the items of two collections by index. If the collections have
+// different lengths, the extra items of the longer collection are ignored.
+func Zip[K comparable, V comparable](keys Collection[K], values Collection[V]) Collection[Pair[K, V]] {
+	count := keys.Count()
+	if values.Count() < count {
+		count = values.Count()
+	}
+
+	pairs := make([]Pair[K, V], count)
+	for i := range pairs {
+		pairs[i] = Pair[K, V]{Key: keys.At(i), Value: values.At(i)}
+	}
+
+	return From(pairs)
+}
+
+// ZipToMap pairs the items of two collections by index into a map, such as the
+// header row and a data row of a CSV file. If the collections have different
+// lengths, an error wrapping collection.ErrLengthMismatch is returned. If a key
+// appears more than once, the value paired with its last occurrence is kept.
+func ZipToMap[K comparable, V comparable](keys Collection[K], values Collection[V]) (map[K]V, error) {
+	if keys.Count() != values.Count() {
+		return nil, fmt.Errorf("%w: %d keys and %d values", ErrLengthMismatch, keys.Count(), values.Count())
+	}
+
+	m := make(map[K]V, keys.Count())
+	for i, k := range keys.All() {
+		m[k] = values.At(i)
+	}
+
+	return m, nil
+}
+
+// KeysOf returns the key of each pair in the collection.
+func KeysOf[K comparable, V comparable](c Collection[Pair[K, V]]) Collection[K] {
+	keys := make([]K, c.Count())
+	for i, p := range c.All() {
+		keys[i] = p.Key
+	}
+
+	return From(keys)
+}
+
+// ValuesOf returns the value of each pair in the collection.
+func ValuesOf[K comparable, V comparable](c Collection[Pair[K, V]]) Collection[V] {
+	values := make([]V, c.Count())
+	for i, p := range c.All() {
+		values[i] = p.Value
+	}
+
+	return From(values)
+}
+
+// MapValues uses the given func to transform the value of each pair in the
+// collection, leaving the keys untouched.
+func MapValues[K comparable, V comparable, W comparable](c Collection[Pair[K, V]], fn func(v V) W) Collection[Pair[K, W]] {
+	pairs := make([]Pair[K, W], c.Count())
+	for i, p := range c.All() {
+		pairs[i] = Pair[K, W]{Key: p.Key, Value: fn(p.Value)}
+	}
+
+	return From(pairs)
+}
+
+// ToMap converts a collection of pairs into a map. If more than one pair has the
+// same key, the value of the last pair is kept.
+func ToMap[K comparable, V comparable](c Collection[Pair[K, V]]) map[K]V {
+	m := make(map[K]V, c.Count())
+	for _, p := range c.All() {
+		m[p.Key] = p.Value
+	}
+
+	return m
+}
+
+// Associate converts a collection into a map, using fn to choose the key and
+// value for each item. If more than one item has the same key, the value for
+// the last item is kept.
+func Associate[T comparable, K comparable, V any](c Collection[T], fn func(v T) (K, V)) map[K]V {
+	m := make(map[K]V, c.Count())
+	for _, v := range c.All() {
+		k, val := fn(v)
+		m[k] = val
+	}
+
+	return m
+}
+
+// FromMap converts a map into a collection of pairs, sorted by key so that the
+// order of the collection is the same on every run. Use FromMapFunc for maps
+// whose keys can't be compared with <.
+func FromMap[K ordered, V comparable](m map[K]V) Collection[Pair[K, V]] {
+	return FromMapFunc(m, func(a K, b K) bool {
+		return a < b
+	})
+}
+
+// FromMapFunc converts a map into a collection of pairs, sorted by key using
+// the given less func.
+func FromMapFunc[K comparable, V comparable](m map[K]V, less func(a K, b K) bool) Collection[Pair[K, V]] {
+	pairs := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, Pair[K, V]{Key: k, Value: v})
+	}
+
+	sort.Slice(pairs, func(i int, j int) bool {
+		return less(pairs[i].Key, pairs[j].Key)
+	})
+
+	return From(pairs)
+}
+
+// FromMapKeys returns a collection of the map's keys, in sorted order.
+func FromMapKeys[K ordered, V any](m map[K]V) Collection[K] {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i int, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	return From(keys)
+}
+
+// FromMapValues returns a collection of the map's values, ordered by their
+// keys.
+func FromMapValues[K ordered, V comparable](m map[K]V) Collection[V] {
+	return ValuesOf(FromMap(m))
+}
+
+// CrossJoin returns every combination of an item from a with an item from b,
+// ordered by the items of a, then by the items of b.
+func CrossJoin[A comparable, B comparable](a Collection[A], b Collection[B]) Collection[Pair[A, B]] {
+	pairs := make([]Pair[A, B], 0, a.Count()*b.Count())
+	for _, x := range a.All() {
+		for _, y := range b.All() {
+			pairs = append(pairs, Pair[A, B]{Key: x, Value: y})
+		}
+	}
+
+	return From(pairs)
+}
+
+// CrossJoinAll returns every combination of one item from each of the given
+// collections, with the items of the last collection varying fastest. For
+// example, CrossJoinAll of [1, 2] and [3, 4] returns [1, 3], [1, 4], [2, 3] and
+// [2, 4]. If no collections are given, or any of them are empty, no
+// combinations are returned.
+func CrossJoinAll[T comparable](cols ...Collection[T]) [][]T {
+	if len(cols) == 0 {
+		return [][]T{}
+	}
+
+	combinations := [][]T{{}}
+	for _, c := range cols {
+		next := make([][]T, 0, len(combinations)*c.Count())
+		for _, combination := range combinations {
+			for _, v := range c.All() {
+				extended := make([]T, len(combination), len(combination)+1)
+				copy(extended, combination)
+				next = append(next, append(extended, v))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
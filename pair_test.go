@@ -0,0 +1,94 @@
+package collection_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	pairs := collection.Zip(collection.From([]string{"a", "b", "c"}), collection.FromRange(1, 2).Collection)
+
+	assert.Equal(t, []collection.Pair[string, int]{{"a", 1}, {"b", 2}}, pairs.All())
+}
+
+func TestZipToMap(t *testing.T) {
+	header := collection.From([]string{"name", "age"})
+
+	m, err := collection.ZipToMap(header, collection.From([]string{"ann", "31"}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "ann", "age": "31"}, m)
+
+	_, err = collection.ZipToMap(header, collection.From([]string{"bob"}))
+	assert.ErrorIs(t, err, collection.ErrLengthMismatch)
+	assert.EqualError(t, err, "collection lengths differ: 2 keys and 1 values")
+}
+
+func TestKeysOfAndValuesOf(t *testing.T) {
+	pairs := collection.Zip(collection.From([]string{"a", "b"}), collection.From([]int{1, 2}))
+
+	assert.Equal(t, []string{"a", "b"}, collection.KeysOf(pairs).All())
+	assert.Equal(t, []int{1, 2}, collection.ValuesOf(pairs).All())
+}
+
+func TestMapValues(t *testing.T) {
+	pairs := collection.Zip(collection.From([]int{1, 2}), collection.From([]string{"a", "b"}))
+	upper := collection.MapValues(pairs, strings.ToUpper)
+
+	assert.Equal(t, []collection.Pair[int, string]{{1, "A"}, {2, "B"}}, upper.All())
+}
+
+func TestToMap(t *testing.T) {
+	pairs := collection.From([]collection.Pair[string, int]{{"a", 1}, {"b", 2}, {"a", 3}})
+
+	assert.Equal(t, map[string]int{"a": 3, "b": 2}, collection.ToMap(pairs))
+}
+
+func TestAssociate(t *testing.T) {
+	words := collection.From([]string{"apple", "banana", "avocado"})
+
+	m := collection.Associate(words, func(v string) (string, int) {
+		return v[:1], len(v)
+	})
+
+	assert.Equal(t, map[string]int{"a": 7, "b": 6}, m)
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "b": 2, "d": 4}
+
+	assert.Equal(t, []collection.Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}}, collection.FromMap(m).All())
+	assert.Equal(t, []string{"a", "b", "c", "d"}, collection.FromMapKeys(m).All())
+	assert.Equal(t, []int{1, 2, 3, 4}, collection.FromMapValues(m).All())
+
+	desc := collection.FromMapFunc(m, func(a string, b string) bool {
+		return a > b
+	})
+	assert.Equal(t, []string{"d", "c", "b", "a"}, collection.KeysOf(desc).All())
+}
+
+func TestCrossJoin(t *testing.T) {
+	pairs := collection.CrossJoin(collection.From([]string{"a", "b"}), collection.From([]int{1, 2}))
+
+	assert.Equal(t, []collection.Pair[string, int]{{"a", 1}, {"a", 2}, {"b", 1}, {"b", 2}}, pairs.All())
+	assert.True(t, collection.CrossJoin(collection.From([]string{"a"}), collection.Make[int]()).Empty())
+}
+
+func TestCrossJoinAll(t *testing.T) {
+	combinations := collection.CrossJoinAll(
+		collection.From([]string{"small", "large"}),
+		collection.From([]string{"red"}),
+		collection.From([]string{"on", "off"}),
+	)
+
+	assert.Equal(t, [][]string{
+		{"small", "red", "on"},
+		{"small", "red", "off"},
+		{"large", "red", "on"},
+		{"large", "red", "off"},
+	}, combinations)
+
+	assert.Empty(t, collection.CrossJoinAll[int]())
+}
@@ -0,0 +1,123 @@
+package collection
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+// ParallelOption configures auto-parallel mode, enabled by AutoParallel.
+type ParallelOption func(*parallelConfig)
+
+type parallelConfig struct {
+	minItems int
+	minWork  time.Duration
+	workers  int
+}
+
+// WithMinItems sets the number of items a collection must hold before Map and
+// Filter consider running in parallel. It defaults to 1000.
+func WithMinItems(n int) ParallelOption {
+	return func(c *parallelConfig) {
+		c.minItems = n
+	}
+}
+
+// WithMinWork sets how long Map and Filter must expect to take before they run
+// in parallel. It defaults to one millisecond.
+func WithMinWork(d time.Duration) ParallelOption {
+	return func(c *parallelConfig) {
+		c.minWork = d
+	}
+}
+
+// WithWorkers sets the number of goroutines used to run Map and Filter in
+// parallel. It defaults to runtime.GOMAXPROCS.
+func WithWorkers(n int) ParallelOption {
+	return func(c *parallelConfig) {
+		c.workers = n
+	}
+}
+
+// AutoParallel enables auto-parallel mode for the collection. In auto-parallel
+// mode, Map and Filter time the first call to the given func, and if the
+// collection is large enough and the estimated time for every item is long
+// enough, the remaining items are split between several goroutines. Otherwise,
+// they run in the usual way, so a cheap func isn't slowed down by the cost of
+// starting goroutines.
+//
+// Funcs passed to Map and Filter in auto-parallel mode may be called
+// concurrently, and in any order. If a func panics, the panic is passed on to
+// the goroutine that called Map or Filter once the other goroutines finish.
+// The collections returned by Map and Filter keep the auto-parallel mode, so
+// that chained calls are parallelised too.
+func (c *Collection[T]) AutoParallel(opts ...ParallelOption) {
+	cfg := parallelConfig{
+		minItems: 1000,
+		minWork:  time.Millisecond,
+		workers:  runtime.GOMAXPROCS(0),
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	c.parallel = &cfg
+}
+
+// runAdaptive calls fn with each index of the collection, in parallel if the
+// collection is in auto-parallel mode and the work is expected to be worth it.
+func (c Collection[T]) runAdaptive(fn func(i int)) {
+	n := c.Count()
+	if c.parallel == nil || n < 2 || n < c.parallel.minItems {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	start := time.Now()
+	fn(0)
+	if time.Since(start)*time.Duration(n) < c.parallel.minWork {
+		for i := 1; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	workers := clamp(c.parallel.workers, 1, n-1)
+	per := (n - 1 + workers - 1) / workers
+
+	var (
+		wg        sync.WaitGroup
+		once      sync.Once
+		recovered any
+	)
+	for lo := 1; lo < n; lo += per {
+		hi := clamp(lo+per, 0, n)
+
+		wg.Add(1)
+		go func(lo int, hi int) {
+			defer wg.Done()
+			// A panic can't be recovered from another goroutine, so keep
+			// the first one and raise it again on the caller's goroutine.
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() {
+						recovered = r
+					})
+				}
+			}()
+
+			for i := lo; i < hi; i++ {
+				fn(i)
+			}
+		}(lo, hi)
+	}
+
+	wg.Wait()
+
+	if recovered != nil {
+		panic(recovered)
+	}
+}
@@ -0,0 +1,60 @@
+package collection_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoParallel(t *testing.T) {
+	col := collection.FromRange(1, 100).Collection
+	col.AutoParallel(collection.WithMinItems(10), collection.WithMinWork(0), collection.WithWorkers(4))
+
+	var calls atomic.Int64
+	doubled := col.Map(func(i int, value int) int {
+		calls.Add(1)
+		return value * 2
+	})
+
+	assert.Equal(t, int64(100), calls.Load())
+	assert.Equal(t, collection.FromRange(1, 100).Map(func(i int, value int) int {
+		return value * 2
+	}).All(), doubled.All())
+
+	evens := doubled.Filter(func(i int, value int) bool {
+		return value%4 == 0
+	})
+	assert.Equal(t, 50, evens.Count())
+	assert.Equal(t, 4, evens.First())
+	assert.Equal(t, 200, evens.Last())
+}
+
+func TestAutoParallelStaysSequentialForCheapWork(t *testing.T) {
+	col := collection.FromRange(1, 100).Collection
+	col.AutoParallel(collection.WithMinItems(10), collection.WithMinWork(time.Hour))
+
+	order := []int{}
+	col.Map(func(i int, value int) int {
+		order = append(order, i)
+		return value
+	})
+
+	assert.Equal(t, collection.FromRange(0, 99).All(), order)
+}
+
+func TestAutoParallelPassesPanicsToCaller(t *testing.T) {
+	col := collection.FromRange(1, 100).Collection
+	col.AutoParallel(collection.WithMinItems(10), collection.WithMinWork(0), collection.WithWorkers(4))
+
+	assert.PanicsWithValue(t, "boom", func() {
+		col.Map(func(i int, value int) int {
+			if value == 50 {
+				panic("boom")
+			}
+			return value
+		})
+	})
+}
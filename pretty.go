@@ -0,0 +1,133 @@
+package collection
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// PrintOption configures the output of PrettyPrint.
+type PrintOption func(*printConfig)
+
+type printConfig struct {
+	maxItems int
+	width    int
+	color    bool
+}
+
+// WithMaxItems limits PrettyPrint to showing n items. Collections with more than
+// n items show their first and last items, with an ellipsis in between. A value
+// of zero or less shows every item. The default is 20.
+func WithMaxItems(n int) PrintOption {
+	return func(c *printConfig) {
+		c.maxItems = n
+	}
+}
+
+// WithWidth truncates each line printed by PrettyPrint to the given number of
+// characters, ending truncated lines with an ellipsis. A value of zero or less
+// disables truncation. The default is 80.
+func WithWidth(width int) PrintOption {
+	return func(c *printConfig) {
+		c.width = width
+	}
+}
+
+// WithColor enables or disables ANSI colors in the output of PrettyPrint.
+// Colors are disabled by default.
+func WithColor(enabled bool) PrintOption {
+	return func(c *printConfig) {
+		c.color = enabled
+	}
+}
+
+const (
+	ansiDim   = "\x1b[2m"
+	ansiReset = "\x1b[0m"
+)
+
+// PrettyPrint writes a human readable representation of the collection to w,
+// with one item per line alongside its index. Indexes are aligned, and output
+// can be limited in length and width using the given options.
+func (c Collection[T]) PrettyPrint(w io.Writer, opts ...PrintOption) error {
+	cfg := printConfig{maxItems: 20, width: 80}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	noun := "items"
+	if c.Count() == 1 {
+		noun = "item"
+	}
+
+	lines := []string{fmt.Sprintf("Collection[%T] (%d %s)", *new(T), c.Count(), noun)}
+	indexWidth := len(fmt.Sprint(c.Count() - 1))
+
+	head, tail := c.Count(), 0
+	if cfg.maxItems > 0 && c.Count() > cfg.maxItems {
+		head = (cfg.maxItems + 1) / 2
+		tail = cfg.maxItems - head
+	}
+
+	// Leave room for the indent and index on each line, but always keep at
+	// least one character of the value, as truncate treats zero as no limit.
+	valueWidth := 0
+	if cfg.width > 0 {
+		valueWidth = clamp(cfg.width-indexWidth-5, 1, cfg.width)
+	}
+
+	line := func(i int) string {
+		index := fmt.Sprintf("[%*d]", indexWidth, i)
+		if cfg.color {
+			index = ansiDim + index + ansiReset
+		}
+
+		return "  " + index + " " + truncate(fmt.Sprintf("%v", c.At(i)), valueWidth)
+	}
+
+	for i := 0; i < head; i++ {
+		lines = append(lines, line(i))
+	}
+
+	if head+tail < c.Count() {
+		lines = append(lines, fmt.Sprintf("  … %d more …", c.Count()-head-tail))
+		for i := c.Count() - tail; i < c.Count(); i++ {
+			lines = append(lines, line(i))
+		}
+	}
+
+	if cfg.width > 0 {
+		lines[0] = truncate(lines[0], cfg.width)
+	}
+
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+
+	return err
+}
+
+// Dump pretty prints the collection to os.Stderr and returns it unchanged, so
+// that the items can be inspected at any point in a chain of calls while
+// debugging.
+func (c Collection[T]) Dump(opts ...PrintOption) Collection[T] {
+	return c.DumpTo(os.Stderr, opts...)
+}
+
+// DumpTo works in the same way as Dump, but pretty prints the collection to w.
+// Any error writing to w is ignored.
+func (c Collection[T]) DumpTo(w io.Writer, opts ...PrintOption) Collection[T] {
+	_ = c.PrettyPrint(w, opts...)
+	return c
+}
+
+// truncate shortens s to the given number of characters, replacing the end of
+// the string with an ellipsis if it is too long. Widths of zero or less leave s
+// untouched.
+func truncate(s string, width int) string {
+	if width <= 0 || utf8.RuneCountInString(s) <= width {
+		return s
+	}
+
+	return string([]rune(s)[:width-1]) + "…"
+}
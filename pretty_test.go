@@ -0,0 +1,71 @@
+package collection_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrettyPrint(t *testing.T) {
+	var b strings.Builder
+	err := collection.From([]string{"one", "two"}).PrettyPrint(&b)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Collection[string] (2 items)\n  [0] one\n  [1] two\n", b.String())
+}
+
+func TestPrettyPrintTruncatesLongCollections(t *testing.T) {
+	var b strings.Builder
+	err := collection.FromRange(1, 12).PrettyPrint(&b, collection.WithMaxItems(4))
+
+	expected := "" +
+		"Collection[int] (12 items)\n" +
+		"  [ 0] 1\n" +
+		"  [ 1] 2\n" +
+		"  … 8 more …\n" +
+		"  [10] 11\n" +
+		"  [11] 12\n"
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, b.String())
+}
+
+func TestPrettyPrintTruncatesWideItems(t *testing.T) {
+	var b strings.Builder
+	err := collection.From([]string{"abcdefghijklmnop"}).PrettyPrint(&b, collection.WithWidth(15))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Collection[str…\n  [0] abcdefgh…\n", b.String())
+}
+
+func TestPrettyPrintVerySmallWidth(t *testing.T) {
+	var b strings.Builder
+	err := collection.From([]string{"abcdefghijklmnop"}).PrettyPrint(&b, collection.WithWidth(5))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Coll…\n  [0] …\n", b.String())
+}
+
+func TestPrettyPrintColor(t *testing.T) {
+	var b strings.Builder
+	err := collection.From([]int{1}).PrettyPrint(&b, collection.WithColor(true))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Collection[int] (1 item)\n  \x1b[2m[0]\x1b[0m 1\n", b.String())
+}
+
+func TestDumpTo(t *testing.T) {
+	var b strings.Builder
+	res := collection.From([]int{1, 2, 3}).
+		DumpTo(&b).
+		Filter(func(i int, value int) bool {
+			return value > 1
+		}).
+		DumpTo(&b, collection.WithMaxItems(1))
+
+	assert.Equal(t, []int{2, 3}, res.All())
+	assert.Equal(t, "Collection[int] (3 items)\n  [0] 1\n  [1] 2\n  [2] 3\n"+
+		"Collection[int] (2 items)\n  [0] 2\n  … 1 more …\n", b.String())
+}
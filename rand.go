@@ -0,0 +1,77 @@
+package collection
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RandSource is a source of random numbers used to pick items from a
+// collection. It is satisfied by *math/rand.Rand, by CryptoRand, and by any
+// deterministic source a test might need.
+type RandSource interface {
+	// Intn returns a random number in the range [0, n).
+	Intn(n int) int
+}
+
+// CryptoRand is a RandSource backed by crypto/rand, for picking items where the
+// choice must not be predictable, such as tokens or prize draws.
+var CryptoRand RandSource = cryptoRand{}
+
+type cryptoRand struct{}
+
+// Intn panics if crypto/rand fails to read, which only happens if the operating
+// system's random number generator is broken.
+func (cryptoRand) Intn(n int) int {
+	v, err := crand.Int(crand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+
+	return int(v.Int64())
+}
+
+// defaultRand is the source used by RandomN and ShuffleDefault. The source is
+// created when it is first used, and calls to it are serialised, as
+// *rand.Rand is not safe for concurrent use.
+var defaultRand struct {
+	sync.Mutex
+	src RandSource
+}
+
+// SetDefaultRandSource replaces the source used by RandomN and ShuffleDefault,
+// which is otherwise a *rand.Rand seeded with the time it is first used. This
+// is mostly useful to make tests deterministic. Passing nil restores the
+// default source.
+func SetDefaultRandSource(src RandSource) {
+	defaultRand.Lock()
+	defer defaultRand.Unlock()
+
+	defaultRand.src = src
+}
+
+type defaultSource struct{}
+
+func (defaultSource) Intn(n int) int {
+	defaultRand.Lock()
+	defer defaultRand.Unlock()
+
+	if defaultRand.src == nil {
+		defaultRand.src = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return defaultRand.src.Intn(n)
+}
+
+// RandomN works in the same way as Random, using the package's default source.
+func (c Collection[T]) RandomN(count int) Collection[T] {
+	return c.Random(defaultSource{}, count)
+}
+
+// ShuffleDefault works in the same way as Shuffle, using the package's default
+// source.
+func (c Collection[T]) ShuffleDefault() Collection[T] {
+	return c.Shuffle(defaultSource{})
+}
@@ -0,0 +1,40 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+// sequence is a deterministic RandSource returning each of its numbers in turn.
+type sequence []int
+
+func (s *sequence) Intn(n int) int {
+	v := (*s)[0] % n
+	*s = (*s)[1:]
+	return v
+}
+
+func TestRandSource(t *testing.T) {
+	col := collection.From([]string{"a", "b", "c"})
+
+	assert.Equal(t, []string{"c", "a", "c"}, col.Random(&sequence{2, 0, 2}, 3).All())
+	assert.Equal(t, []string{"b", "a", "c"}, col.Shuffle(&sequence{1, 0, 0}).All())
+
+	sample := col.Sample(collection.CryptoRand, 2)
+	assert.Equal(t, 2, sample.Unique().Count())
+	assert.Empty(t, sample.Diff(col).All())
+}
+
+func TestDefaultRandSource(t *testing.T) {
+	col := collection.From([]string{"a", "b", "c"})
+
+	assert.Equal(t, 5, col.RandomN(5).Count())
+	assert.ElementsMatch(t, col.All(), col.ShuffleDefault().All())
+
+	collection.SetDefaultRandSource(&sequence{1, 2, 0})
+	defer collection.SetDefaultRandSource(nil)
+
+	assert.Equal(t, []string{"b", "c", "a"}, col.RandomN(3).All())
+}
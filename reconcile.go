@@ -0,0 +1,37 @@
+package collection
+
+// SyncTo computes the difference between the collection, the desired state, and
+// the given target, the actual state, and passes the result to apply:
+//   - add contains the items in the collection that are missing from target
+//   - remove contains the items in target that are missing from the collection
+//   - keep contains the items in the collection that are also in target
+//
+// Any error returned by apply is returned by SyncTo.
+func (c Collection[T]) SyncTo(target Collection[T], apply func(add, remove, keep Collection[T]) error) error {
+	desired := make(map[T]struct{}, c.Count())
+	for _, v := range c.All() {
+		desired[v] = struct{}{}
+	}
+
+	actual := make(map[T]struct{}, target.Count())
+	for _, v := range target.All() {
+		actual[v] = struct{}{}
+	}
+
+	add := c.Filter(func(i int, v T) bool {
+		_, found := actual[v]
+		return !found
+	})
+
+	remove := target.Filter(func(i int, v T) bool {
+		_, found := desired[v]
+		return !found
+	})
+
+	keep := c.Filter(func(i int, v T) bool {
+		_, found := actual[v]
+		return found
+	})
+
+	return apply(add, remove, keep)
+}
@@ -0,0 +1,33 @@
+package collection_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncTo(t *testing.T) {
+	desired := collection.From([]string{"web", "worker", "cron"})
+	actual := collection.From([]string{"cron", "legacy", "web"})
+
+	err := desired.SyncTo(actual, func(add, remove, keep collection.Collection[string]) error {
+		assert.Equal(t, []string{"worker"}, add.All())
+		assert.Equal(t, []string{"legacy"}, remove.All())
+		assert.Equal(t, []string{"web", "cron"}, keep.All())
+		return nil
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestSyncToReturnsApplyError(t *testing.T) {
+	failure := errors.New("failed")
+
+	err := collection.From([]int{1}).SyncTo(collection.Make[int](), func(add, remove, keep collection.Collection[int]) error {
+		return failure
+	})
+
+	assert.ErrorIs(t, err, failure)
+}
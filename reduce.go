@@ -0,0 +1,57 @@
+package collection
+
+// Reduce combines the items of the collection into a single value, calling fn
+// for each item in turn with the result of the previous call, starting with
+// initial.
+func Reduce[T comparable, R any](c Collection[T], initial R, fn func(acc R, value T) R) R {
+	acc := initial
+	for _, v := range c.contents {
+		acc = fn(acc, v)
+	}
+
+	return acc
+}
+
+// ReduceWhile works in the same way as Reduce, but stops as soon as fn returns
+// false, returning the value fn returned with it. This allows a search-style
+// reduction to finish as soon as the answer is known.
+func ReduceWhile[T comparable, R any](c Collection[T], initial R, fn func(acc R, value T) (R, bool)) R {
+	acc := initial
+	for _, v := range c.contents {
+		var more bool
+		if acc, more = fn(acc, v); !more {
+			break
+		}
+	}
+
+	return acc
+}
+
+// ReduceRight works in the same way as Reduce, but calls fn for the items from
+// last to first. This is useful for building right-associative results, such as
+// wrapping each item around the ones after it, without reversing the
+// collection first.
+func ReduceRight[T comparable, R any](c Collection[T], initial R, fn func(acc R, value T) R) R {
+	acc := initial
+	for i := len(c.contents) - 1; i >= 0; i-- {
+		acc = fn(acc, c.contents[i])
+	}
+
+	return acc
+}
+
+// Scan works in the same way as Reduce, but returns every intermediate result
+// rather than just the final one, such as a running total or running maximum.
+// The returned collection holds one result per item, and doesn't include
+// initial.
+func Scan[T comparable, R comparable](c Collection[T], initial R, fn func(acc R, value T) R) Collection[R] {
+	results := make([]R, c.Count())
+
+	acc := initial
+	for i, v := range c.contents {
+		acc = fn(acc, v)
+		results[i] = acc
+	}
+
+	return From(results)
+}
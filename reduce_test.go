@@ -0,0 +1,55 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReduce(t *testing.T) {
+	col := collection.From([]string{"a", "bb", "ccc"})
+
+	total := collection.Reduce(col, 0, func(acc int, value string) int {
+		return acc + len(value)
+	})
+
+	assert.Equal(t, 6, total)
+}
+
+func TestReduceRight(t *testing.T) {
+	col := collection.From([]string{"a", "b", "c"})
+
+	nested := collection.ReduceRight(col, "x", func(acc string, value string) string {
+		return value + "(" + acc + ")"
+	})
+
+	assert.Equal(t, "a(b(c(x)))", nested)
+}
+
+func TestReduceWhile(t *testing.T) {
+	calls := 0
+	sum := collection.ReduceWhile(collection.FromRange(1, 100).Collection, 0, func(acc int, value int) (int, bool) {
+		calls++
+		acc += value
+		return acc, acc < 10
+	})
+
+	assert.Equal(t, 10, sum)
+	assert.Equal(t, 4, calls)
+}
+
+func TestScan(t *testing.T) {
+	sums := collection.Scan(collection.FromRange(1, 5).Collection, 0, func(acc int, value int) int {
+		return acc + value
+	})
+	assert.Equal(t, []int{1, 3, 6, 10, 15}, sums.All())
+
+	maxima := collection.Scan(collection.From([]int{3, 1, 4, 1, 5}), 0, func(acc int, value int) int {
+		if value > acc {
+			return value
+		}
+		return acc
+	})
+	assert.Equal(t, []int{3, 3, 4, 4, 5}, maxima.All())
+}
@@ -0,0 +1,106 @@
+package render
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gostalt/collection"
+)
+
+// Column describes a single column of a rendered table: the header shown at
+// the top of the column, and a func returning the cell value for an item.
+type Column[T comparable] struct {
+	Header string
+	Value  func(v T) string
+}
+
+// MarkdownTable renders the collection as a Markdown table with the given
+// columns, one row per item. Cells are padded so the table is aligned when read
+// as plain text. Pipes are escaped and newlines are replaced with <br>.
+func MarkdownTable[T comparable](c collection.Collection[T], columns ...Column[T]) string {
+	cells := make([][]string, c.Count()+1)
+	widths := make([]int, len(columns))
+
+	cells[0] = make([]string, len(columns))
+	for i, col := range columns {
+		cells[0][i] = markdownEscape(col.Header)
+	}
+
+	c.Each(func(i int, value T) {
+		cells[i+1] = make([]string, len(columns))
+		for j, col := range columns {
+			cells[i+1][j] = markdownEscape(col.Value(value))
+		}
+	})
+
+	for _, row := range cells {
+		for i, cell := range row {
+			if w := utf8.RuneCountInString(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	// Markdown requires at least three dashes in each divider cell.
+	for i := range widths {
+		if widths[i] < 3 {
+			widths[i] = 3
+		}
+	}
+
+	var b strings.Builder
+	for i, row := range cells {
+		markdownRow(&b, row, widths)
+		if i == 0 {
+			divider := make([]string, len(columns))
+			for j, w := range widths {
+				divider[j] = strings.Repeat("-", w)
+			}
+			markdownRow(&b, divider, widths)
+		}
+	}
+
+	return b.String()
+}
+
+func markdownRow(b *strings.Builder, row []string, widths []int) {
+	b.WriteString("|")
+	for i, cell := range row {
+		b.WriteString(" ")
+		b.WriteString(cell)
+		b.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
+
+var markdownReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func markdownEscape(s string) string {
+	return markdownReplacer.Replace(s)
+}
+
+// HTMLTable renders the collection as an HTML table with the given columns, one
+// row per item. Headers and cell values are HTML escaped.
+func HTMLTable[T comparable](c collection.Collection[T], columns ...Column[T]) string {
+	var b strings.Builder
+
+	b.WriteString("<table>\n<thead>\n<tr>")
+	for _, col := range columns {
+		b.WriteString("<th>" + html.EscapeString(col.Header) + "</th>")
+	}
+	b.WriteString("</tr>\n</thead>\n<tbody>\n")
+
+	c.Each(func(i int, value T) {
+		b.WriteString("<tr>")
+		for _, col := range columns {
+			b.WriteString("<td>" + html.EscapeString(col.Value(value)) + "</td>")
+		}
+		b.WriteString("</tr>\n")
+	})
+
+	b.WriteString("</tbody>\n</table>\n")
+
+	return b.String()
+}
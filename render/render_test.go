@@ -0,0 +1,48 @@
+package render_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/gostalt/collection/render"
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+var columns = []render.Column[user]{
+	{Header: "ID", Value: func(v user) string { return strconv.Itoa(v.id) }},
+	{Header: "Name", Value: func(v user) string { return v.name }},
+}
+
+func TestMarkdownTable(t *testing.T) {
+	col := collection.From([]user{{1, "Tom"}, {20, "Ann|Bob"}})
+
+	expected := "" +
+		"| ID  | Name     |\n" +
+		"| --- | -------- |\n" +
+		"| 1   | Tom      |\n" +
+		"| 20  | Ann\\|Bob |\n"
+
+	assert.Equal(t, expected, render.MarkdownTable(col, columns...))
+}
+
+func TestHTMLTable(t *testing.T) {
+	col := collection.From([]user{{1, "<Tom>"}})
+
+	expected := "" +
+		"<table>\n" +
+		"<thead>\n" +
+		"<tr><th>ID</th><th>Name</th></tr>\n" +
+		"</thead>\n" +
+		"<tbody>\n" +
+		"<tr><td>1</td><td>&lt;Tom&gt;</td></tr>\n" +
+		"</tbody>\n" +
+		"</table>\n"
+
+	assert.Equal(t, expected, render.HTMLTable(col, columns...))
+}
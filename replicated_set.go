@@ -0,0 +1,233 @@
+package collection
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Tag uniquely identifies a single add operation on a ReplicatedSet.
+type Tag struct {
+	Node string
+	Seq  uint64
+}
+
+func (t Tag) less(o Tag) bool {
+	if t.Node != o.Node {
+		return t.Node < o.Node
+	}
+
+	return t.Seq < o.Seq
+}
+
+// ReplicatedSet is a conflict-free replicated set (an observed-remove set) that
+// can be changed independently on several nodes and synchronized without
+// coordination using Merge.
+//
+// Every add is tagged with the node that made it, and a remove only removes the
+// adds that the removing node had observed. This means that if one node adds an
+// item while another concurrently removes it, the add wins once the two are
+// merged. A ReplicatedSet that is never removed from behaves as a grow-only set.
+//
+// ReplicatedSet implements json.Marshaler and json.Unmarshaler, so its state can
+// be sent to replicas on other nodes, decoded there and passed to Merge.
+type ReplicatedSet[T comparable] struct {
+	node    string
+	seq     uint64
+	adds    map[T]map[Tag]struct{}
+	removes map[Tag]struct{}
+}
+
+// NewReplicatedSet returns a new empty ReplicatedSet for the given node. Each
+// replica of the set must use a different node name.
+func NewReplicatedSet[T comparable](node string) *ReplicatedSet[T] {
+	return &ReplicatedSet[T]{
+		node:    node,
+		adds:    make(map[T]map[Tag]struct{}),
+		removes: make(map[Tag]struct{}),
+	}
+}
+
+// Add adds the given values to the set.
+func (s *ReplicatedSet[T]) Add(values ...T) {
+	for _, v := range values {
+		s.seq++
+		if s.adds[v] == nil {
+			s.adds[v] = make(map[Tag]struct{})
+		}
+		s.adds[v][Tag{Node: s.node, Seq: s.seq}] = struct{}{}
+	}
+}
+
+// Remove removes the given values from the set. Only the adds this replica has
+// observed are removed, so concurrent adds on other replicas survive a merge.
+func (s *ReplicatedSet[T]) Remove(values ...T) {
+	for _, v := range values {
+		for tag := range s.adds[v] {
+			s.removes[tag] = struct{}{}
+		}
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *ReplicatedSet[T]) Contains(value T) bool {
+	_, ok := s.live(value)
+	return ok
+}
+
+// live returns the smallest tag of the given value that has not been removed,
+// and whether such a tag exists.
+func (s *ReplicatedSet[T]) live(value T) (Tag, bool) {
+	var min Tag
+	found := false
+
+	for tag := range s.adds[value] {
+		if _, removed := s.removes[tag]; removed {
+			continue
+		}
+
+		if !found || tag.less(min) {
+			min = tag
+			found = true
+		}
+	}
+
+	return min, found
+}
+
+// Merge merges the state of the remote replica into this one. Merge is
+// commutative, associative and idempotent, so replicas that have merged the same
+// states always contain the same values, whatever order they were merged in.
+func (s *ReplicatedSet[T]) Merge(remote *ReplicatedSet[T]) {
+	for v, tags := range remote.adds {
+		if s.adds[v] == nil {
+			s.adds[v] = make(map[Tag]struct{}, len(tags))
+		}
+
+		for tag := range tags {
+			s.adds[v][tag] = struct{}{}
+			if tag.Node == s.node && tag.Seq > s.seq {
+				s.seq = tag.Seq
+			}
+		}
+	}
+
+	for tag := range remote.removes {
+		s.removes[tag] = struct{}{}
+	}
+}
+
+// Collection returns the values in the set as a collection. Values are ordered
+// by their earliest surviving add, so that replicas with the same state return
+// the same collection.
+func (s *ReplicatedSet[T]) Collection() Collection[T] {
+	type entry struct {
+		value T
+		tag   Tag
+	}
+
+	entries := make([]entry, 0, len(s.adds))
+	for v := range s.adds {
+		if tag, ok := s.live(v); ok {
+			entries = append(entries, entry{v, tag})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].tag.less(entries[j].tag)
+	})
+
+	values := make([]T, len(entries))
+	for i, e := range entries {
+		values[i] = e.value
+	}
+
+	return From(values)
+}
+
+// replicatedSetJSON is the JSON encoding of a ReplicatedSet's state.
+type replicatedSetJSON[T comparable] struct {
+	Node    string                 `json:"node"`
+	Seq     uint64                 `json:"seq"`
+	Adds    []replicatedAddJSON[T] `json:"adds"`
+	Removes []Tag                  `json:"removes"`
+}
+
+// replicatedAddJSON is the JSON encoding of every add of a single value.
+type replicatedAddJSON[T comparable] struct {
+	Value T     `json:"value"`
+	Tags  []Tag `json:"tags"`
+}
+
+// MarshalJSON encodes the state of the set, including removed values, so that
+// it can be decoded by UnmarshalJSON on another node and merged into a replica.
+// Replicas with the same state are encoded identically.
+func (s *ReplicatedSet[T]) MarshalJSON() ([]byte, error) {
+	state := replicatedSetJSON[T]{
+		Node:    s.node,
+		Seq:     s.seq,
+		Adds:    make([]replicatedAddJSON[T], 0, len(s.adds)),
+		Removes: make([]Tag, 0, len(s.removes)),
+	}
+
+	for v, tags := range s.adds {
+		add := replicatedAddJSON[T]{Value: v, Tags: make([]Tag, 0, len(tags))}
+		for tag := range tags {
+			add.Tags = append(add.Tags, tag)
+		}
+		sortTags(add.Tags)
+		state.Adds = append(state.Adds, add)
+	}
+
+	sort.Slice(state.Adds, func(i, j int) bool {
+		return state.Adds[i].Tags[0].less(state.Adds[j].Tags[0])
+	})
+
+	for tag := range s.removes {
+		state.Removes = append(state.Removes, tag)
+	}
+	sortTags(state.Removes)
+
+	return json.Marshal(state)
+}
+
+// UnmarshalJSON decodes the state of a set encoded by MarshalJSON, replacing the
+// set's current state.
+func (s *ReplicatedSet[T]) UnmarshalJSON(data []byte) error {
+	var state replicatedSetJSON[T]
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	decoded := NewReplicatedSet[T](state.Node)
+	decoded.seq = state.Seq
+	for _, add := range state.Adds {
+		if len(add.Tags) == 0 {
+			continue
+		}
+
+		if decoded.adds[add.Value] == nil {
+			decoded.adds[add.Value] = make(map[Tag]struct{}, len(add.Tags))
+		}
+
+		for _, tag := range add.Tags {
+			decoded.adds[add.Value][tag] = struct{}{}
+			if tag.Node == decoded.node && tag.Seq > decoded.seq {
+				decoded.seq = tag.Seq
+			}
+		}
+	}
+
+	for _, tag := range state.Removes {
+		decoded.removes[tag] = struct{}{}
+	}
+
+	*s = *decoded
+
+	return nil
+}
+
+func sortTags(tags []Tag) {
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].less(tags[j])
+	})
+}
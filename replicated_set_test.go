@@ -0,0 +1,87 @@
+package collection_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicatedSet(t *testing.T) {
+	s := collection.NewReplicatedSet[string]("a")
+	s.Add("x", "y", "z")
+	s.Remove("y")
+
+	assert.True(t, s.Contains("x"))
+	assert.False(t, s.Contains("y"))
+	assert.Equal(t, []string{"x", "z"}, s.Collection().All())
+}
+
+func TestReplicatedSetMergeIsCommutativeAndIdempotent(t *testing.T) {
+	a := collection.NewReplicatedSet[int]("a")
+	b := collection.NewReplicatedSet[int]("b")
+
+	a.Add(1, 2)
+	b.Merge(a)
+	b.Add(3)
+	b.Remove(1)
+	a.Add(4)
+
+	a.Merge(b)
+	b.Merge(a)
+	a.Merge(b)
+	b.Merge(b)
+
+	assert.Equal(t, []int{2, 4, 3}, a.Collection().All())
+	assert.Equal(t, a.Collection().All(), b.Collection().All())
+}
+
+func TestReplicatedSetConcurrentAddWins(t *testing.T) {
+	a := collection.NewReplicatedSet[string]("a")
+	a.Add("x")
+
+	b := collection.NewReplicatedSet[string]("b")
+	b.Merge(a)
+
+	a.Remove("x")
+	b.Add("x")
+
+	a.Merge(b)
+	b.Merge(a)
+
+	assert.True(t, a.Contains("x"))
+	assert.True(t, b.Contains("x"))
+}
+
+func TestReplicatedSetJSONRoundTrip(t *testing.T) {
+	a := collection.NewReplicatedSet[string]("a")
+	a.Add("x", "y", "z")
+	a.Remove("y")
+
+	data, err := json.Marshal(a)
+	assert.NoError(t, err)
+
+	var decoded collection.ReplicatedSet[string]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, a.Collection().All(), decoded.Collection().All())
+
+	again, err := json.Marshal(&decoded)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), string(again))
+
+	b := collection.NewReplicatedSet[string]("b")
+	b.Add("w")
+	b.Merge(&decoded)
+	assert.Equal(t, []string{"x", "z", "w"}, b.Collection().All())
+
+	// A remove sent back to a is applied there too.
+	b.Remove("x")
+	data, err = json.Marshal(b)
+	assert.NoError(t, err)
+
+	var fromB collection.ReplicatedSet[string]
+	assert.NoError(t, json.Unmarshal(data, &fromB))
+	a.Merge(&fromB)
+	assert.Equal(t, []string{"z", "w"}, a.Collection().All())
+}
@@ -0,0 +1,65 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ResumeToken records the position reached by EachResumable, so that iteration
+// can later continue from the same place. The zero value starts from the
+// beginning of the collection.
+//
+// ResumeToken implements encoding.TextMarshaler and encoding.TextUnmarshaler,
+// so it can be persisted and used to resume iteration after a restart.
+type ResumeToken struct {
+	// Next is the index of the next item to be processed.
+	Next int
+}
+
+// MarshalText encodes the token as text.
+func (t ResumeToken) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(t.Next)), nil
+}
+
+// UnmarshalText decodes a token previously encoded with MarshalText.
+func (t *ResumeToken) UnmarshalText(text []byte) error {
+	next, err := strconv.Atoi(string(text))
+	if err != nil || next < 0 {
+		return fmt.Errorf("collection: invalid resume token %q", text)
+	}
+
+	t.Next = next
+
+	return nil
+}
+
+// EachResumable iterates over the items in the collection, starting at the
+// position recorded in the given token, and passes the index and value to the
+// provided func. Iteration stops if fn returns an error or the given context is
+// Done, and the returned token records where to resume from: the failed item,
+// or the first unprocessed item.
+//
+// If the collection is in fail-fast mode and is changed during iteration,
+// collection.ErrConcurrentModification is returned.
+//
+// Once every item has been processed, the returned token's Next is equal to the
+// collection's Count, and the returned error is nil.
+func (c Collection[T]) EachResumable(ctx context.Context, token ResumeToken, fn func(i int, value T) error) (ResumeToken, error) {
+	start := c.modCount()
+	for i := token.Next; i < c.Count(); i++ {
+		if err := ctx.Err(); err != nil {
+			return ResumeToken{Next: i}, err
+		}
+
+		if err := fn(i, c.At(i)); err != nil {
+			return ResumeToken{Next: i}, err
+		}
+
+		if c.modified(start) {
+			return ResumeToken{Next: i + 1}, ErrConcurrentModification
+		}
+	}
+
+	return ResumeToken{Next: c.Count()}, nil
+}
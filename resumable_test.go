@@ -0,0 +1,66 @@
+package collection_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEachResumable(t *testing.T) {
+	col := collection.FromRange(1, 5)
+	failure := errors.New("failed")
+	var seen []int
+
+	token, err := col.EachResumable(context.Background(), collection.ResumeToken{}, func(i int, value int) error {
+		if value == 3 && len(seen) == 2 {
+			return failure
+		}
+		seen = append(seen, value)
+		return nil
+	})
+
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 2, token.Next)
+
+	token, err = col.EachResumable(context.Background(), token, func(i int, value int) error {
+		seen = append(seen, value)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, token.Next)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, seen)
+}
+
+func TestEachResumableStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sum := 0
+
+	token, err := collection.FromRange(1, 5).EachResumable(ctx, collection.ResumeToken{}, func(i int, value int) error {
+		sum += value
+		if value == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, token.Next)
+	assert.Equal(t, 3, sum)
+}
+
+func TestResumeTokenPersists(t *testing.T) {
+	encoded, err := json.Marshal(collection.ResumeToken{Next: 42})
+	assert.NoError(t, err)
+	assert.Equal(t, `"42"`, string(encoded))
+
+	var token collection.ResumeToken
+	assert.NoError(t, json.Unmarshal(encoded, &token))
+	assert.Equal(t, 42, token.Next)
+
+	assert.Error(t, token.UnmarshalText([]byte("nope")))
+}
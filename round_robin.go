@@ -0,0 +1,34 @@
+package collection
+
+// RoundRobin interleaves the given collections into a single collection. In each
+// round, up to weights[i] items are taken from the i-th collection, in order,
+// until every collection has been exhausted. For example, weights of []int{2, 1}
+// take two items from the first collection for every one from the second.
+//
+// Collections without a weight, or with a weight less than one, are given a
+// weight of one.
+func RoundRobin[T comparable](weights []int, cols ...Collection[T]) Collection[T] {
+	total := 0
+	for _, c := range cols {
+		total += c.Count()
+	}
+
+	offsets := make([]int, len(cols))
+	contents := make([]T, 0, total)
+
+	for len(contents) < total {
+		for i, c := range cols {
+			weight := 1
+			if i < len(weights) && weights[i] > 1 {
+				weight = weights[i]
+			}
+
+			for j := 0; j < weight && offsets[i] < c.Count(); j++ {
+				contents = append(contents, c.At(offsets[i]))
+				offsets[i]++
+			}
+		}
+	}
+
+	return From(contents)
+}
@@ -0,0 +1,28 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundRobin(t *testing.T) {
+	high := collection.From([]string{"h1", "h2", "h3", "h4", "h5"})
+	low := collection.From([]string{"l1", "l2"})
+
+	mixed := collection.RoundRobin([]int{2, 1}, high, low)
+
+	assert.Equal(t, []string{"h1", "h2", "l1", "h3", "h4", "l2", "h5"}, mixed.All())
+}
+
+func TestRoundRobinDefaultsWeights(t *testing.T) {
+	mixed := collection.RoundRobin(nil,
+		collection.From([]int{1, 4}),
+		collection.From([]int{2}),
+		collection.From([]int{3, 5, 6}),
+	)
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, mixed.All())
+	assert.True(t, collection.RoundRobin[int](nil).Empty())
+}
@@ -0,0 +1,183 @@
+package collection
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ToRows converts a collection of structs into a slice of rows, with one row per
+// item. Each row maps a column name to the value of a struct field, where the
+// column name is read from the given struct tag. Fields without the tag use
+// their field name, and fields tagged with "-" or that are unexported are
+// skipped. Anything after a comma in the tag, such as ",omitempty", is ignored.
+//
+// T must be a struct or a pointer to a struct, otherwise ToRows panics. Nil
+// pointers are converted to empty rows.
+func (c Collection[T]) ToRows(tag string) []map[string]any {
+	fields, err := rowFields(reflect.TypeOf((*T)(nil)).Elem(), tag)
+	if err != nil {
+		panic(err)
+	}
+
+	rows := make([]map[string]any, c.Count())
+	for i, v := range c.All() {
+		row := make(map[string]any, len(fields))
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				rows[i] = row
+				continue
+			}
+			rv = rv.Elem()
+		}
+
+		for name, index := range fields {
+			row[name] = rv.Field(index).Interface()
+		}
+
+		rows[i] = row
+	}
+
+	return rows
+}
+
+// FromRowsInto creates a new collection of structs from the given rows, the
+// inverse of ToRows. Columns are matched to struct fields using the given struct
+// tag in the same way as ToRows, and columns with no matching field are
+// ignored.
+//
+// An error wrapping collection.ErrNotStruct is returned if T is not a struct or
+// a pointer to a struct, and an error wrapping collection.ErrFieldType is
+// returned if a value can't be converted to its field's type. Values are only
+// converted between numeric types, or between types of the same kind, so an
+// int is never turned into a string.
+func FromRowsInto[T comparable](rows []map[string]any, tag string) (Collection[T], error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	fields, err := rowFields(typ, tag)
+	if err != nil {
+		return Make[T](), err
+	}
+
+	contents := make([]T, len(rows))
+	for i, row := range rows {
+		item := reflect.New(typ).Elem()
+		st := item
+		if typ.Kind() == reflect.Pointer {
+			item.Set(reflect.New(typ.Elem()))
+			st = item.Elem()
+		}
+
+		for name, value := range row {
+			index, ok := fields[name]
+			if !ok || value == nil {
+				continue
+			}
+
+			field := st.Field(index)
+			rv := reflect.ValueOf(value)
+			switch {
+			case rv.Type().AssignableTo(field.Type()):
+				field.Set(rv)
+			default:
+				converted, ok := rowConvert(rv, field.Type())
+				if !ok {
+					return Make[T](), fmt.Errorf("%w: row %d column %q: %s %v into %s", ErrFieldType, i, name, rv.Type(), value, field.Type())
+				}
+				field.Set(converted)
+			}
+		}
+
+		contents[i] = item.Interface().(T)
+	}
+
+	return From(contents), nil
+}
+
+// rowConvert converts v to a field of type to. Conversions are only made
+// between numeric types, or between types of the same kind, and numbers must be
+// represented exactly by the new type, in the same way as ConvertChecked.
+func rowConvert(v reflect.Value, to reflect.Type) (reflect.Value, bool) {
+	from := v.Type()
+	if !from.ConvertibleTo(to) {
+		return reflect.Value{}, false
+	}
+
+	if !isNumericKind(from.Kind()) || !isNumericKind(to.Kind()) {
+		if from.Kind() != to.Kind() {
+			return reflect.Value{}, false
+		}
+		return v.Convert(to), true
+	}
+
+	converted := v.Convert(to)
+	if isNaN(v) && isNaN(converted) {
+		return converted, true
+	}
+
+	exact := converted.Convert(from).Equal(v) && isNegative(v) == isNegative(converted)
+
+	return converted, exact
+}
+
+// isNaN returns true if v is a floating point NaN.
+func isNaN(v reflect.Value) bool {
+	k := v.Kind()
+	return (k == reflect.Float32 || k == reflect.Float64) && v.Float() != v.Float()
+}
+
+// isNegative returns true if v is a number less than zero.
+func isNegative(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() < 0
+	}
+
+	return false
+}
+
+// isNumericKind returns true if k is an integer or floating point kind.
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// rowFields returns a map of column names to field indexes for the given struct
+// type, or pointer to struct type.
+func rowFields(typ reflect.Type, tag string) (map[string]int, error) {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s", ErrNotStruct, typ)
+	}
+
+	fields := make(map[string]int, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[name] = i
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,91 @@
+package collection_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+type rowUser struct {
+	ID       int    `row:"id"`
+	Name     string `row:"name,omitempty"`
+	Email    string
+	Password string `row:"-"`
+	internal bool
+}
+
+func TestToRows(t *testing.T) {
+	rows := collection.From([]rowUser{
+		{ID: 1, Name: "Tom", Email: "tom@example.com", Password: "secret"},
+		{ID: 2, Name: "Ann", Email: "ann@example.com"},
+	}).ToRows("row")
+
+	assert.Equal(t, []map[string]any{
+		{"id": 1, "name": "Tom", "Email": "tom@example.com"},
+		{"id": 2, "name": "Ann", "Email": "ann@example.com"},
+	}, rows)
+
+	pointers := collection.From([]*rowUser{{ID: 3}, nil}).ToRows("row")
+	assert.Equal(t, []map[string]any{{"id": 3, "name": "", "Email": ""}, {}}, pointers)
+
+	assert.Panics(t, func() {
+		collection.From([]int{1}).ToRows("row")
+	})
+}
+
+func TestFromRowsInto(t *testing.T) {
+	col, err := collection.FromRowsInto[rowUser]([]map[string]any{
+		{"id": 1, "name": "Tom", "Email": "tom@example.com", "unknown": true},
+		{"id": int64(2), "name": "Ann", "Password": "ignored"},
+	}, "row")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []rowUser{
+		{ID: 1, Name: "Tom", Email: "tom@example.com"},
+		{ID: 2, Name: "Ann"},
+	}, col.All())
+
+	pointers, err := collection.FromRowsInto[*rowUser]([]map[string]any{{"id": 3}}, "row")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, pointers.First().ID)
+
+	_, err = collection.FromRowsInto[rowUser]([]map[string]any{{"id": []int{1}}}, "row")
+	assert.ErrorIs(t, err, collection.ErrFieldType)
+
+	_, err = collection.FromRowsInto[rowUser]([]map[string]any{{"name": 65}}, "row")
+	assert.ErrorIs(t, err, collection.ErrFieldType)
+
+	converted, err := collection.FromRowsInto[rowUser]([]map[string]any{{"id": 4.0}}, "row")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, converted.First().ID)
+
+	_, err = collection.FromRowsInto[int]([]map[string]any{{"id": 1}}, "row")
+	assert.ErrorIs(t, err, collection.ErrNotStruct)
+}
+
+func TestFromRowsIntoRejectsInexactNumbers(t *testing.T) {
+	type sizes struct {
+		Int   int
+		Small int8
+		Count uint
+		Ratio float32
+	}
+
+	for _, row := range []map[string]any{
+		{"Int": 4.7},
+		{"Small": 300},
+		{"Count": -1},
+		{"Int": math.Inf(1)},
+	} {
+		_, err := collection.FromRowsInto[sizes]([]map[string]any{row}, "row")
+		assert.ErrorIs(t, err, collection.ErrFieldType, "%v", row)
+	}
+
+	col, err := collection.FromRowsInto[sizes]([]map[string]any{
+		{"Int": 4.0, "Small": int64(-128), "Count": 7, "Ratio": 0.5},
+	}, "row")
+	assert.NoError(t, err)
+	assert.Equal(t, sizes{Int: 4, Small: -128, Count: 7, Ratio: 0.5}, col.First())
+}
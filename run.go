@@ -0,0 +1,72 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ItemError records an error that occurred while processing the item at Index.
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// RunAll calls fn for every item in the collection, using the given number of
+// concurrent workers, and collects every result and every error rather than
+// stopping at the first failure. Results are returned in the same order as the
+// collection, with a zero value in place of any item that failed.
+//
+// Each error is returned as an *ItemError recording the index of the item that
+// failed, ordered by index. If the context is Done before an item is started,
+// the context's error is recorded for that item instead of calling fn.
+func RunAll[T comparable, R comparable](ctx context.Context, c Collection[T], workers int, fn func(ctx context.Context, value T) (R, error)) (Collection[R], []error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]R, c.Count())
+	failures := make([]error, c.Count())
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					failures[i] = err
+					continue
+				}
+
+				results[i], failures[i] = fn(ctx, c.At(i))
+			}
+		}()
+	}
+
+	for i := 0; i < c.Count(); i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var errs []error
+	for i, err := range failures {
+		if err != nil {
+			var zero R
+			results[i] = zero
+			errs = append(errs, &ItemError{Index: i, Err: err})
+		}
+	}
+
+	return From(results), errs
+}
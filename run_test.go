@@ -0,0 +1,51 @@
+package collection_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunAll(t *testing.T) {
+	odd := errors.New("odd")
+	col := collection.FromRange(1, 6).Collection
+
+	results, errs := collection.RunAll(context.Background(), col, 3, func(ctx context.Context, value int) (int, error) {
+		if value%2 == 1 {
+			return value, odd
+		}
+		return value * 10, nil
+	})
+
+	assert.Equal(t, []int{0, 20, 0, 40, 0, 60}, results.All())
+	assert.Len(t, errs, 3)
+
+	for i, index := range []int{0, 2, 4} {
+		var itemErr *collection.ItemError
+		assert.ErrorAs(t, errs[i], &itemErr)
+		assert.Equal(t, index, itemErr.Index)
+		assert.ErrorIs(t, errs[i], odd)
+	}
+}
+
+func TestRunAllSkipsItemsOnceCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+
+	results, errs := collection.RunAll(ctx, collection.FromRange(1, 5).Collection, 1, func(ctx context.Context, value int) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		if value == 2 {
+			cancel()
+		}
+		return "ok", nil
+	})
+
+	assert.Equal(t, int32(2), calls)
+	assert.Equal(t, []string{"ok", "ok", "", "", ""}, results.All())
+	assert.Len(t, errs, 3)
+	assert.ErrorIs(t, errs[0], context.Canceled)
+}
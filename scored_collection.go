@@ -0,0 +1,188 @@
+package collection
+
+import "sort"
+
+// Scored pairs a value with the score it was given by WithScores.
+type Scored[T comparable] struct {
+	Value T
+	Score float64
+}
+
+// ScoredCollection is a collection of scored values, ranked from the highest
+// score to the lowest.
+//
+// A ScoredCollection can't embed a Collection[Scored[T]], as methods on
+// Collection[T] returning one would cause an instantiation cycle.
+type ScoredCollection[T comparable] struct {
+	contents []Scored[T]
+}
+
+// WithScores uses the provided func to score every item in the collection and
+// returns a ScoredCollection ranked from the highest score to the lowest. Items
+// with equal scores retain their original relative order.
+func (c Collection[T]) WithScores(score func(v T) float64) ScoredCollection[T] {
+	scored := make([]Scored[T], c.Count())
+	for i, v := range c.All() {
+		scored[i] = Scored[T]{Value: v, Score: score(v)}
+	}
+
+	return rank(scored)
+}
+
+// rank sorts the given scored values by descending score and wraps them in a
+// ScoredCollection.
+func rank[T comparable](scored []Scored[T]) ScoredCollection[T] {
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].Score > scored[j].Score
+	})
+
+	return ScoredCollection[T]{contents: scored}
+}
+
+// All returns the ranked values along with their scores.
+func (c ScoredCollection[T]) All() []Scored[T] {
+	return c.contents
+}
+
+// Count returns the total length of the collection.
+func (c ScoredCollection[T]) Count() int {
+	return len(c.contents)
+}
+
+// Empty returns true if the collection contains no items.
+func (c ScoredCollection[T]) Empty() bool {
+	return c.Count() == 0
+}
+
+// TopByScore returns the n highest scoring items. If the collection has fewer
+// than n items, all of them are returned, and if n is negative, none are.
+func (c ScoredCollection[T]) TopByScore(n int) ScoredCollection[T] {
+	if c.Count() <= n {
+		return c
+	}
+
+	n = clamp(n, 0, c.Count())
+
+	return ScoredCollection[T]{contents: c.contents[:n]}
+}
+
+// Threshold returns the items with a score greater than or equal to min.
+func (c ScoredCollection[T]) Threshold(min float64) ScoredCollection[T] {
+	// The contents are ranked, so everything past the first item below min is
+	// also below min.
+	for i, v := range c.contents {
+		if v.Score < min {
+			return ScoredCollection[T]{contents: c.contents[:i]}
+		}
+	}
+
+	return c
+}
+
+// Normalized returns the collection with its scores rescaled to between 0 and 1,
+// where the highest score becomes 1 and the lowest becomes 0. If every item has
+// the same score, all scores are set to 1.
+func (c ScoredCollection[T]) Normalized() ScoredCollection[T] {
+	if c.Empty() {
+		return c
+	}
+
+	max := c.contents[0].Score
+	min := c.contents[c.Count()-1].Score
+	spread := max - min
+
+	normalized := make([]Scored[T], c.Count())
+	for i, v := range c.contents {
+		if spread == 0 {
+			v.Score = 1
+		} else {
+			v.Score = (v.Score - min) / spread
+		}
+
+		normalized[i] = v
+	}
+
+	return ScoredCollection[T]{contents: normalized}
+}
+
+// Values returns the ranked values without their scores.
+func (c ScoredCollection[T]) Values() Collection[T] {
+	values := make([]T, c.Count())
+	for i, v := range c.contents {
+		values[i] = v.Value
+	}
+
+	return From(values)
+}
+
+// Scores returns the ranked scores without their values.
+func (c ScoredCollection[T]) Scores() []float64 {
+	scores := make([]float64, c.Count())
+	for i, v := range c.contents {
+		scores[i] = v.Score
+	}
+
+	return scores
+}
+
+// RangeByScore returns the items with a score between min and max, inclusive.
+func (c ScoredCollection[T]) RangeByScore(min float64, max float64) ScoredCollection[T] {
+	contents := []Scored[T]{}
+	for _, v := range c.contents {
+		if v.Score >= min && v.Score <= max {
+			contents = append(contents, v)
+		}
+	}
+
+	return ScoredCollection[T]{contents: contents}
+}
+
+// RemoveRangeByRank returns the collection without the items ranked between
+// start and stop, inclusive, where the highest scoring item has a rank of 0. In
+// the same way as Redis, negative ranks count back from the lowest scoring item,
+// so -1 is the lowest scoring item. Ranks are clamped to the bounds of the
+// collection.
+func (c ScoredCollection[T]) RemoveRangeByRank(start int, stop int) ScoredCollection[T] {
+	if start < 0 {
+		start += c.Count()
+	}
+	if stop < 0 {
+		stop += c.Count()
+	}
+
+	// Clamp stop before making it exclusive, so that math.MaxInt can't
+	// overflow.
+	start = clamp(start, 0, c.Count())
+	stop = clamp(stop, -1, c.Count()-1) + 1
+	if start >= stop {
+		return c
+	}
+
+	contents := make([]Scored[T], 0, c.Count()-(stop-start))
+	contents = append(contents, c.contents[:start]...)
+	contents = append(contents, c.contents[stop:]...)
+
+	return ScoredCollection[T]{contents: contents}
+}
+
+// IncrScore returns the collection with delta added to the score of every item
+// equal to v, re-ranked to account for the new scores. If v is not in the
+// collection, it is added with a score of delta.
+func (c ScoredCollection[T]) IncrScore(v T, delta float64) ScoredCollection[T] {
+	contents := make([]Scored[T], c.Count(), c.Count()+1)
+	copy(contents, c.contents)
+
+	found := false
+	for i := range contents {
+		if contents[i].Value == v {
+			contents[i].Score += delta
+			found = true
+		}
+	}
+
+	if !found {
+		contents = append(contents, Scored[T]{Value: v, Score: delta})
+	}
+
+	return rank(contents)
+}
@@ -0,0 +1,72 @@
+package collection_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func length(v string) float64 {
+	return float64(len(v))
+}
+
+func TestWithScores(t *testing.T) {
+	ranked := collection.From([]string{"bb", "a", "dddd", "cc"}).WithScores(length)
+
+	assert.Equal(t, []string{"dddd", "bb", "cc", "a"}, ranked.Values().All())
+	assert.Equal(t, []float64{4, 2, 2, 1}, ranked.Scores())
+}
+
+func TestTopByScore(t *testing.T) {
+	ranked := collection.From([]string{"bb", "a", "dddd", "ccc"}).WithScores(length)
+
+	assert.Equal(t, []string{"dddd", "ccc"}, ranked.TopByScore(2).Values().All())
+	assert.Equal(t, 4, ranked.TopByScore(10).Count())
+	assert.True(t, ranked.TopByScore(-1).Empty())
+}
+
+func TestThreshold(t *testing.T) {
+	ranked := collection.From([]string{"bb", "a", "dddd", "ccc"}).WithScores(length)
+
+	assert.Equal(t, []string{"dddd", "ccc", "bb"}, ranked.Threshold(2).Values().All())
+	assert.True(t, ranked.Threshold(5).Empty())
+}
+
+func TestNormalized(t *testing.T) {
+	ranked := collection.From([]string{"a", "ccc", "bb", "eeeee"}).WithScores(length).Normalized()
+	assert.Equal(t, []float64{1, 0.5, 0.25, 0}, ranked.Scores())
+
+	flat := collection.From([]string{"a", "b"}).WithScores(length).Normalized()
+	assert.Equal(t, []float64{1, 1}, flat.Scores())
+}
+
+func TestRangeByScore(t *testing.T) {
+	ranked := collection.From([]string{"a", "bb", "ccc", "dddd"}).WithScores(length)
+
+	assert.Equal(t, []string{"ccc", "bb"}, ranked.RangeByScore(2, 3).Values().All())
+	assert.True(t, ranked.RangeByScore(5, 10).Empty())
+}
+
+func TestRemoveRangeByRank(t *testing.T) {
+	ranked := collection.From([]string{"a", "bb", "ccc", "dddd"}).WithScores(length)
+
+	assert.Equal(t, []string{"dddd", "a"}, ranked.RemoveRangeByRank(1, 2).Values().All())
+	assert.Equal(t, []string{"dddd", "ccc"}, ranked.RemoveRangeByRank(-2, -1).Values().All())
+	assert.Equal(t, []string{"ccc", "bb", "a"}, ranked.RemoveRangeByRank(0, 0).Values().All())
+	assert.Equal(t, 4, ranked.RemoveRangeByRank(3, 1).Count())
+	assert.True(t, ranked.RemoveRangeByRank(0, math.MaxInt).Empty())
+}
+
+func TestIncrScore(t *testing.T) {
+	ranked := collection.From([]string{"a", "bb", "ccc"}).WithScores(length)
+
+	bumped := ranked.IncrScore("a", 5)
+	assert.Equal(t, []string{"a", "ccc", "bb"}, bumped.Values().All())
+	assert.Equal(t, []float64{6, 3, 2}, bumped.Scores())
+	assert.Equal(t, []float64{3, 2, 1}, ranked.Scores())
+
+	added := ranked.IncrScore("new", 2.5)
+	assert.Equal(t, []string{"ccc", "new", "bb", "a"}, added.Values().All())
+}
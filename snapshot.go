@@ -0,0 +1,246 @@
+package collection
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"math"
+)
+
+// Compression selects how a snapshot is compressed.
+type Compression byte
+
+const (
+	// NoCompression writes snapshots uncompressed.
+	NoCompression Compression = iota
+	// GzipCompression compresses snapshots using gzip.
+	GzipCompression
+)
+
+// SnapshotOption configures the output of Snapshot.
+type SnapshotOption func(*snapshotConfig)
+
+type snapshotConfig struct {
+	compression Compression
+	checksum    bool
+}
+
+// WithCompression compresses the snapshot using the given compression.
+func WithCompression(compression Compression) SnapshotOption {
+	return func(c *snapshotConfig) {
+		c.compression = compression
+	}
+}
+
+// WithChecksum adds a checksum to the snapshot, so that Restore can detect
+// corrupted data.
+func WithChecksum() SnapshotOption {
+	return func(c *snapshotConfig) {
+		c.checksum = true
+	}
+}
+
+var snapshotMagic = []byte("GCSNAP\x01")
+
+const (
+	snapshotGzip byte = 1 << iota
+	snapshotChecksum
+)
+
+var snapshotTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Snapshot writes every item in the collection to w, encoded using the given
+// codec, so that the collection can later be rebuilt using Restore. Snapshots
+// can optionally be compressed and checksummed using the given options.
+func (c Collection[T]) Snapshot(w io.Writer, codec Codec[T], opts ...SnapshotOption) error {
+	var cfg snapshotConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var flags byte
+	switch cfg.compression {
+	case NoCompression:
+	case GzipCompression:
+		flags |= snapshotGzip
+	default:
+		return fmt.Errorf("collection: unknown compression %d", cfg.compression)
+	}
+	if cfg.checksum {
+		flags |= snapshotChecksum
+	}
+
+	if _, err := w.Write(append(snapshotMagic, flags)); err != nil {
+		return err
+	}
+
+	body := w
+	var gz *gzip.Writer
+	if flags&snapshotGzip != 0 {
+		gz = gzip.NewWriter(w)
+		body = gz
+	}
+
+	bw := bufio.NewWriter(body)
+	sum := crc32.New(snapshotTable)
+	out := io.MultiWriter(bw, sum)
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	if _, err := out.Write(buf[:binary.PutUvarint(buf, uint64(c.Count()))]); err != nil {
+		return err
+	}
+
+	for _, v := range c.All() {
+		data, err := codec.Encode(v)
+		if err != nil {
+			return err
+		}
+
+		if _, err := out.Write(buf[:binary.PutUvarint(buf, uint64(len(data)))]); err != nil {
+			return err
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+
+	if cfg.checksum {
+		if _, err := bw.Write(sum.Sum(nil)); err != nil {
+			return err
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+
+	if gz != nil {
+		return gz.Close()
+	}
+
+	return nil
+}
+
+// Restore rebuilds a collection from a snapshot written by Snapshot, decoding
+// each item using the given codec. Compression and checksums are detected
+// automatically. An error wrapping collection.ErrCorruptSnapshot is returned if
+// the snapshot is malformed or fails its checksum.
+func Restore[T comparable](r io.Reader, codec Codec[T]) (Collection[T], error) {
+	header := make([]byte, len(snapshotMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return Make[T](), fmt.Errorf("%w: %v", ErrCorruptSnapshot, err)
+	}
+
+	if !bytes.Equal(header[:len(snapshotMagic)], snapshotMagic) {
+		return Make[T](), fmt.Errorf("%w: not a snapshot", ErrCorruptSnapshot)
+	}
+
+	flags := header[len(snapshotMagic)]
+	body := r
+	if flags&snapshotGzip != 0 {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return Make[T](), fmt.Errorf("%w: %v", ErrCorruptSnapshot, err)
+		}
+		defer gz.Close()
+		body = gz
+	}
+
+	sum := crc32.New(snapshotTable)
+	br := bufio.NewReader(body)
+	in := &snapshotReader{r: br, sum: sum}
+
+	count, err := binary.ReadUvarint(in)
+	if err != nil {
+		return Make[T](), fmt.Errorf("%w: %v", ErrCorruptSnapshot, err)
+	}
+
+	contents := make([]T, 0, min64(count, 1<<16))
+	for i := uint64(0); i < count; i++ {
+		size, err := binary.ReadUvarint(in)
+		if err != nil {
+			return Make[T](), fmt.Errorf("%w: item %d: %v", ErrCorruptSnapshot, i, err)
+		}
+
+		data, err := readSnapshotItem(in, size)
+		if err != nil {
+			return Make[T](), fmt.Errorf("%w: item %d: %v", ErrCorruptSnapshot, i, err)
+		}
+
+		v, err := codec.Decode(data)
+		if err != nil {
+			return Make[T](), fmt.Errorf("%w: item %d: %v", ErrCorruptSnapshot, i, err)
+		}
+
+		contents = append(contents, v)
+	}
+
+	if flags&snapshotChecksum != 0 {
+		expected := make([]byte, crc32.Size)
+		if _, err := io.ReadFull(br, expected); err != nil {
+			return Make[T](), fmt.Errorf("%w: missing checksum", ErrCorruptSnapshot)
+		}
+
+		if !bytes.Equal(expected, sum.Sum(nil)) {
+			return Make[T](), fmt.Errorf("%w: checksum mismatch", ErrCorruptSnapshot)
+		}
+	}
+
+	return From(contents), nil
+}
+
+// readSnapshotItem reads an encoded item of the given size from r. The size is
+// read from the snapshot itself, so rather than allocating it up front, the
+// buffer only grows as data actually arrives.
+func readSnapshotItem(r io.Reader, size uint64) ([]byte, error) {
+	if size > math.MaxInt64 {
+		return nil, fmt.Errorf("item size %d too large", size)
+	}
+
+	var data bytes.Buffer
+	n, err := data.ReadFrom(io.LimitReader(r, int64(size)))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(n) != size {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return data.Bytes(), nil
+}
+
+// snapshotReader reads from a snapshot body, adding everything it reads to a
+// running checksum.
+type snapshotReader struct {
+	r   *bufio.Reader
+	sum hash.Hash32
+}
+
+func (s *snapshotReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	s.sum.Write(p[:n])
+
+	return n, err
+}
+
+func (s *snapshotReader) ReadByte() (byte, error) {
+	b, err := s.r.ReadByte()
+	if err == nil {
+		s.sum.Write([]byte{b})
+	}
+
+	return b, err
+}
+
+func min64(a uint64, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,72 @@
+package collection_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotAndRestore(t *testing.T) {
+	col := collection.From([]string{"a", "b\nc", ""})
+	codec := collection.JSONCodec[string]{}
+
+	for name, opts := range map[string][]collection.SnapshotOption{
+		"plain":    nil,
+		"gzip":     {collection.WithCompression(collection.GzipCompression)},
+		"checksum": {collection.WithChecksum()},
+		"both":     {collection.WithCompression(collection.GzipCompression), collection.WithChecksum()},
+	} {
+		var b bytes.Buffer
+		assert.NoError(t, col.Snapshot(&b, codec, opts...), name)
+
+		restored, err := collection.Restore[string](&b, codec)
+		assert.NoError(t, err, name)
+		assert.Equal(t, col.All(), restored.All(), name)
+	}
+}
+
+func TestSnapshotCompresses(t *testing.T) {
+	col := collection.FromRange(1, 1000).Map(func(i int, value int) int {
+		return 7
+	})
+	codec := collection.JSONCodec[int]{}
+
+	var plain, compressed bytes.Buffer
+	assert.NoError(t, col.Snapshot(&plain, codec))
+	assert.NoError(t, col.Snapshot(&compressed, codec, collection.WithCompression(collection.GzipCompression)))
+
+	assert.Less(t, compressed.Len(), plain.Len()/10)
+}
+
+func TestRestoreDetectsCorruption(t *testing.T) {
+	codec := collection.JSONCodec[int]{}
+
+	var b bytes.Buffer
+	assert.NoError(t, collection.FromRange(1, 10).Snapshot(&b, codec, collection.WithChecksum()))
+
+	data := b.Bytes()
+	data[len(data)-6] ^= 0x01
+
+	_, err := collection.Restore[int](bytes.NewReader(data), codec)
+	assert.ErrorIs(t, err, collection.ErrCorruptSnapshot)
+
+	_, err = collection.Restore[int](bytes.NewReader([]byte("nonsense")), codec)
+	assert.ErrorIs(t, err, collection.ErrCorruptSnapshot)
+}
+
+func TestRestoreRejectsOversizedItems(t *testing.T) {
+	codec := collection.JSONCodec[int]{}
+
+	for _, size := range [][]byte{
+		{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x01},                   // 2^42 bytes
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}, // 2^64-1 bytes
+	} {
+		data := append([]byte("GCSNAP\x01\x00\x01"), size...)
+		data = append(data, '1')
+
+		_, err := collection.Restore[int](bytes.NewReader(data), codec)
+		assert.ErrorIs(t, err, collection.ErrCorruptSnapshot)
+	}
+}
@@ -0,0 +1,53 @@
+package collection
+
+import "unsafe"
+
+// AllocStats describes the memory held by a collection's underlying slice.
+type AllocStats struct {
+	// Len is the number of items in the collection.
+	Len int
+	// Cap is the capacity of the underlying slice.
+	Cap int
+	// ItemSize is the size in bytes of a single item in the underlying array.
+	ItemSize int
+	// Bytes is the size in bytes of the underlying array, including any spare
+	// capacity.
+	Bytes int
+	// Shared is true if another collection has claimed the spare capacity of
+	// the underlying array, so the next Append has to copy every item.
+	Shared bool
+}
+
+// AllocStats returns the memory statistics of the collection's underlying
+// slice. Comparing Len and Cap shows how much of the array is wasted, which is
+// useful when deciding whether to call Clip.
+func (c Collection[T]) AllocStats() AllocStats {
+	var zero T
+	size := int(unsafe.Sizeof(zero))
+
+	return AllocStats{
+		Len:      c.Count(),
+		Cap:      c.Cap(),
+		ItemSize: size,
+		Bytes:    c.Cap() * size,
+		Shared:   c.tail != nil && *c.tail != c.Count(),
+	}
+}
+
+// EstimateBytes returns an estimate of the memory used by the collection: the
+// size of the underlying array, plus the result of sizeof for each item. sizeof
+// should return the size of any memory the item refers to, such as the bytes
+// of a string or the struct behind a pointer, and may be nil for items which
+// don't refer to any.
+func (c Collection[T]) EstimateBytes(sizeof func(v T) int) int {
+	total := c.AllocStats().Bytes
+	if sizeof == nil {
+		return total
+	}
+
+	for _, v := range c.contents {
+		total += sizeof(v)
+	}
+
+	return total
+}
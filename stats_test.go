@@ -0,0 +1,33 @@
+package collection_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocStats(t *testing.T) {
+	col := collection.From(make([]int64, 2, 8))
+
+	assert.Equal(t, collection.AllocStats{
+		Len:      2,
+		Cap:      8,
+		ItemSize: 8,
+		Bytes:    64,
+	}, col.AllocStats())
+
+	col.Append(1)
+	assert.True(t, col.AllocStats().Shared)
+}
+
+func TestEstimateBytes(t *testing.T) {
+	col := collection.From([]string{"a", "bb", "ccc"})
+	size := int(unsafe.Sizeof(""))
+
+	assert.Equal(t, 3*size, col.EstimateBytes(nil))
+	assert.Equal(t, 3*size+6, col.EstimateBytes(func(v string) int {
+		return len(v)
+	}))
+}
@@ -0,0 +1,41 @@
+package collection
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// WriteTable writes the collection to w as tab-separated values, ready to be
+// pasted or imported into a spreadsheet. The headers are written as the first
+// row, unless they are empty, followed by one row per item produced by the
+// given func. Values containing tabs, quotes or newlines are quoted.
+func (c Collection[T]) WriteTable(w io.Writer, headers []string, row func(v T) []string) error {
+	return c.writeDelimited(w, '\t', headers, row)
+}
+
+// WriteCSV works in the same way as WriteTable, but writes comma-separated
+// values instead.
+func (c Collection[T]) WriteCSV(w io.Writer, headers []string, row func(v T) []string) error {
+	return c.writeDelimited(w, ',', headers, row)
+}
+
+func (c Collection[T]) writeDelimited(w io.Writer, delim rune, headers []string, row func(v T) []string) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = delim
+
+	if len(headers) > 0 {
+		if err := cw.Write(headers); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range c.All() {
+		if err := cw.Write(row(v)); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
@@ -0,0 +1,45 @@
+package collection_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+type tableRow struct {
+	id   int
+	name string
+}
+
+func tableCells(v tableRow) []string {
+	return []string{strconv.Itoa(v.id), v.name}
+}
+
+func TestWriteTable(t *testing.T) {
+	col := collection.From([]tableRow{{1, "Tom"}, {2, "Smith\tJr"}, {3, `"Ann"`}})
+
+	var b strings.Builder
+	err := col.WriteTable(&b, []string{"ID", "Name"}, tableCells)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ID\tName\n1\tTom\n2\t\"Smith\tJr\"\n3\t\"\"\"Ann\"\"\"\n", b.String())
+
+	b.Reset()
+	err = col.FirstX(1).WriteTable(&b, nil, tableCells)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1\tTom\n", b.String())
+}
+
+func TestWriteCSV(t *testing.T) {
+	col := collection.From([]tableRow{{1, "Smith, Tom"}})
+
+	var b strings.Builder
+	err := col.WriteCSV(&b, []string{"ID", "Name"}, tableCells)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ID,Name\n1,\"Smith, Tom\"\n", b.String())
+}
@@ -0,0 +1,153 @@
+package collection
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// TimedCollection is a collection where each item records when it was added, so
+// that items older than a given age can be evicted. It is safe for concurrent
+// use.
+type TimedCollection[T comparable] struct {
+	mu      sync.Mutex
+	entries []Timed[T]
+	maxAge  time.Duration
+	now     func() time.Time
+}
+
+// Timed pairs a value with the time it was added to a TimedCollection.
+type Timed[T comparable] struct {
+	Value T
+	At    time.Time
+}
+
+// TimedOption configures a TimedCollection.
+type TimedOption func(*timedConfig)
+
+type timedConfig struct {
+	maxAge time.Duration
+	now    func() time.Time
+}
+
+// WithMaxAge makes the TimedCollection automatically evict items older than the
+// given age whenever it is read from.
+func WithMaxAge(d time.Duration) TimedOption {
+	return func(c *timedConfig) {
+		c.maxAge = d
+	}
+}
+
+// WithClock replaces the func used to read the current time, which defaults to
+// time.Now.
+func WithClock(now func() time.Time) TimedOption {
+	return func(c *timedConfig) {
+		c.now = now
+	}
+}
+
+// NewTimed returns a new empty TimedCollection.
+func NewTimed[T comparable](opts ...TimedOption) *TimedCollection[T] {
+	cfg := timedConfig{now: time.Now}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return &TimedCollection[T]{maxAge: cfg.maxAge, now: cfg.now}
+}
+
+// Add adds the given values to the collection, timestamped with the current
+// time.
+func (c *TimedCollection[T]) Add(values ...T) {
+	c.AddAt(c.now(), values...)
+}
+
+// AddAt adds the given values to the collection, timestamped with the given
+// time. Items are kept in time order, so values added with an earlier time are
+// placed before any later items.
+func (c *TimedCollection[T]) AddAt(at time.Time, values ...T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i := sort.Search(len(c.entries), func(i int) bool {
+		return c.entries[i].At.After(at)
+	})
+
+	added := make([]Timed[T], len(values))
+	for j, v := range values {
+		added[j] = Timed[T]{Value: v, At: at}
+	}
+
+	c.entries = append(c.entries[:i], append(added, c.entries[i:]...)...)
+}
+
+// EvictOlderThan removes every item that was added more than d ago, and returns
+// the number of items removed.
+func (c *TimedCollection[T]) EvictOlderThan(d time.Duration) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.evict(d)
+}
+
+// evict removes items older than d. The caller must hold c.mu.
+func (c *TimedCollection[T]) evict(d time.Duration) int {
+	cutoff := c.now().Add(-d)
+	i := sort.Search(len(c.entries), func(i int) bool {
+		return !c.entries[i].At.Before(cutoff)
+	})
+
+	if i > 0 {
+		// Reslice rather than copy, so that reads stay cheap. Clear the
+		// evicted entries so their values can be garbage collected; the
+		// space they held is dropped the next time append grows the slice.
+		var zero Timed[T]
+		for j := range c.entries[:i] {
+			c.entries[j] = zero
+		}
+		c.entries = c.entries[i:]
+	}
+
+	return i
+}
+
+// read evicts expired items, if the collection has a maximum age, and returns
+// the remaining entries. The caller must hold c.mu.
+func (c *TimedCollection[T]) read() []Timed[T] {
+	if c.maxAge > 0 {
+		c.evict(c.maxAge)
+	}
+
+	return c.entries
+}
+
+// Entries returns the items in the collection along with the time they were
+// added, oldest first.
+func (c *TimedCollection[T]) Entries() []Timed[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Timed[T]{}, c.read()...)
+}
+
+// Collection returns the items in the collection, oldest first.
+func (c *TimedCollection[T]) Collection() Collection[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := c.read()
+	values := make([]T, len(entries))
+	for i, e := range entries {
+		values[i] = e.Value
+	}
+
+	return From(values)
+}
+
+// Count returns the number of items in the collection.
+func (c *TimedCollection[T]) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.read())
+}
@@ -0,0 +1,61 @@
+package collection_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestTimedEvictOlderThan(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	col := collection.NewTimed[string](collection.WithClock(clock.Now))
+
+	col.Add("a")
+	clock.Advance(time.Minute)
+	col.Add("b", "c")
+	clock.Advance(time.Minute)
+
+	assert.Equal(t, 1, col.EvictOlderThan(90*time.Second))
+	assert.Equal(t, []string{"b", "c"}, col.Collection().All())
+	assert.Equal(t, 0, col.EvictOlderThan(time.Minute))
+}
+
+func TestTimedEvictsOnReadWithMaxAge(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	col := collection.NewTimed[int](collection.WithClock(clock.Now), collection.WithMaxAge(time.Minute))
+
+	col.Add(1)
+	clock.Advance(30 * time.Second)
+	col.Add(2)
+	assert.Equal(t, 2, col.Count())
+
+	clock.Advance(45 * time.Second)
+	assert.Equal(t, []int{2}, col.Collection().All())
+}
+
+func TestTimedAddAtKeepsTimeOrder(t *testing.T) {
+	col := collection.NewTimed[int]()
+	base := time.Unix(1000, 0)
+
+	col.AddAt(base.Add(2*time.Second), 3)
+	col.AddAt(base, 1)
+	col.AddAt(base.Add(time.Second), 2)
+
+	entries := col.Entries()
+	assert.Equal(t, []int{1, 2, 3}, col.Collection().All())
+	assert.Equal(t, base, entries[0].At)
+}
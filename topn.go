@@ -0,0 +1,78 @@
+package collection
+
+// TopN returns the n largest items in the collection according to less, from
+// largest to smallest. Rather than sorting the whole collection, it keeps the
+// largest items seen so far in a heap of size n, so it takes O(len·log n)
+// time. If the collection has fewer than n items, every item is returned. The
+// order of items that are equal according to less is unspecified.
+func (c Collection[T]) TopN(n int, less func(a T, b T) bool) Collection[T] {
+	n = clamp(n, 0, c.Count())
+	if n == 0 {
+		return Make[T]()
+	}
+
+	// h is a min-heap, so the smallest of the items kept is at the root, ready
+	// to be replaced by any larger item.
+	h := make([]T, 0, n)
+	for _, v := range c.contents {
+		if len(h) < n {
+			h = append(h, v)
+			heapUp(h, len(h)-1, less)
+		} else if less(h[0], v) {
+			h[0] = v
+			heapDown(h, 0, less)
+		}
+	}
+
+	// Repeatedly moving the smallest item to the end sorts h from largest to
+	// smallest.
+	for end := len(h) - 1; end > 0; end-- {
+		h[0], h[end] = h[end], h[0]
+		heapDown(h[:end], 0, less)
+	}
+
+	return From(h)
+}
+
+// BottomN returns the n smallest items in the collection according to less,
+// from smallest to largest. It works in the same way as TopN.
+func (c Collection[T]) BottomN(n int, less func(a T, b T) bool) Collection[T] {
+	return c.TopN(n, func(a T, b T) bool {
+		return less(b, a)
+	})
+}
+
+// heapUp moves the item at i towards the root of the min-heap h until its
+// parent is no larger than it.
+func heapUp[T any](h []T, i int, less func(a T, b T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(h[i], h[parent]) {
+			return
+		}
+
+		h[i], h[parent] = h[parent], h[i]
+		i = parent
+	}
+}
+
+// heapDown moves the item at i away from the root of the min-heap h until
+// neither of its children is smaller than it.
+func heapDown[T any](h []T, i int, less func(a T, b T) bool) {
+	for {
+		smallest := i
+		if left := 2*i + 1; left < len(h) && less(h[left], h[smallest]) {
+			smallest = left
+		}
+		if right := 2*i + 2; right < len(h) && less(h[right], h[smallest]) {
+			smallest = right
+		}
+
+		if smallest == i {
+			return
+		}
+
+		h[i], h[smallest] = h[smallest], h[i]
+		i = smallest
+	}
+}
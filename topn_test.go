@@ -0,0 +1,33 @@
+package collection_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopN(t *testing.T) {
+	less := func(a int, b int) bool {
+		return a < b
+	}
+
+	col := collection.From([]int{5, 1, 9, 3, 7, 9, 2})
+
+	assert.Equal(t, []int{9, 9, 7}, col.TopN(3, less).All())
+	assert.Equal(t, []int{1, 2, 3}, col.BottomN(3, less).All())
+	assert.Equal(t, []int{9, 9, 7, 5, 3, 2, 1}, col.TopN(10, less).All())
+	assert.True(t, col.TopN(0, less).Empty())
+
+	r := rand.New(rand.NewSource(1))
+	large := collection.FromRange(1, 10000).Shuffle(r)
+	top := large.TopN(50, less).All()
+
+	assert.True(t, sort.SliceIsSorted(top, func(i int, j int) bool {
+		return top[i] > top[j]
+	}))
+	assert.Equal(t, 10000, top[0])
+	assert.Equal(t, 9951, top[49])
+}
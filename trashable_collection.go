@@ -0,0 +1,131 @@
+package collection
+
+// TrashableCollection is a mutable collection where removed items are moved to
+// a trash, rather than being discarded, so that they can later be restored.
+type TrashableCollection[T comparable] struct {
+	c     Collection[T]
+	trash []trashed[T]
+}
+
+// trashed records a removed item along with the index it was removed from.
+type trashed[T comparable] struct {
+	value T
+	index int
+}
+
+// FromTrashable creates a new TrashableCollection from a copy of the provided
+// slice.
+func FromTrashable[T comparable](slice []T) *TrashableCollection[T] {
+	return &TrashableCollection[T]{c: FromCopy(slice)}
+}
+
+// WithTrash returns a TrashableCollection containing a copy of the collection's
+// items.
+func (c Collection[T]) WithTrash() *TrashableCollection[T] {
+	return FromTrashable(c.All())
+}
+
+// Collection returns the items that have not been removed.
+func (c *TrashableCollection[T]) Collection() Collection[T] {
+	return c.c
+}
+
+// All returns the items that have not been removed.
+func (c *TrashableCollection[T]) All() []T {
+	return c.c.All()
+}
+
+// RemoveAt moves the item at the given index to the trash. If the index does not
+// exist in the collection, collection.ErrIndexOutOfRange is returned.
+func (c *TrashableCollection[T]) RemoveAt(i int) error {
+	new, err := c.c.SafeRemoveAt(i)
+	if err != nil {
+		return err
+	}
+
+	c.trash = append(c.trash, trashed[T]{value: c.c.At(i), index: i})
+	c.c = new
+
+	return nil
+}
+
+// RemoveWhere moves every item that matches the given predicate to the trash,
+// and returns the number of items that were removed.
+func (c *TrashableCollection[T]) RemoveWhere(predicate func(i int, value T) bool) int {
+	kept := make([]T, 0, c.c.Count())
+	removed := 0
+
+	for i, v := range c.c.All() {
+		if predicate(i, v) {
+			c.trash = append(c.trash, trashed[T]{value: v, index: i - removed})
+			removed++
+			continue
+		}
+
+		kept = append(kept, v)
+	}
+
+	c.c = From(kept)
+
+	return removed
+}
+
+// Trashed returns the items in the trash, in the order they were removed.
+func (c *TrashableCollection[T]) Trashed() Collection[T] {
+	values := make([]T, len(c.trash))
+	for i, t := range c.trash {
+		values[i] = t.value
+	}
+
+	return From(values)
+}
+
+// Restore moves every item in the trash that matches the given predicate back
+// into the collection, and returns the number of items that were restored. The
+// index passed to the predicate is the item's index in Trashed.
+//
+// Items are restored in the reverse order they were removed, each at the index
+// it was removed from, so restoring the most recent removals undoes them
+// exactly.
+func (c *TrashableCollection[T]) Restore(predicate func(i int, value T) bool) int {
+	restore := make([]bool, len(c.trash))
+	for i, t := range c.trash {
+		restore[i] = predicate(i, t.value)
+	}
+
+	restored := 0
+
+	for i := len(c.trash) - 1; i >= 0; i-- {
+		t := c.trash[i]
+		if !restore[i] {
+			continue
+		}
+
+		index := t.index
+		if index > c.c.Count() {
+			index = c.c.Count()
+		}
+
+		contents := make([]T, 0, c.c.Count()+1)
+		contents = append(contents, c.c.All()[:index]...)
+		contents = append(contents, t.value)
+		contents = append(contents, c.c.All()[index:]...)
+		c.c = From(contents)
+		restored++
+	}
+
+	kept := make([]trashed[T], 0, len(c.trash)-restored)
+	for i, t := range c.trash {
+		if !restore[i] {
+			kept = append(kept, t)
+		}
+	}
+	c.trash = kept
+
+	return restored
+}
+
+// Purge permanently discards every item in the trash.
+func (c *TrashableCollection[T]) Purge() {
+	c.trash = nil
+}
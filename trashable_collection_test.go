@@ -0,0 +1,55 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrashableRemoveAndRestore(t *testing.T) {
+	col := collection.FromTrashable([]string{"a", "b", "c", "d", "e"})
+
+	removed := col.RemoveWhere(func(i int, value string) bool {
+		return value == "b" || value == "d"
+	})
+	assert.Equal(t, 2, removed)
+	assert.NoError(t, col.RemoveAt(0))
+	assert.ErrorIs(t, col.RemoveAt(5), collection.ErrIndexOutOfRange)
+
+	assert.Equal(t, []string{"c", "e"}, col.All())
+	assert.Equal(t, []string{"b", "d", "a"}, col.Trashed().All())
+
+	restored := col.Restore(func(i int, value string) bool {
+		return true
+	})
+	assert.Equal(t, 3, restored)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, col.All())
+	assert.True(t, col.Trashed().Empty())
+}
+
+func TestTrashableRestoreSome(t *testing.T) {
+	col := collection.From([]int{1, 2, 3}).WithTrash()
+	col.RemoveAt(0)
+	col.RemoveAt(0)
+
+	restored := col.Restore(func(i int, value int) bool {
+		return value == 2
+	})
+
+	assert.Equal(t, 1, restored)
+	assert.Equal(t, []int{2, 3}, col.All())
+	assert.Equal(t, []int{1}, col.Trashed().All())
+}
+
+func TestTrashablePurge(t *testing.T) {
+	col := collection.FromTrashable([]int{1, 2, 3})
+	col.RemoveAt(1)
+	col.Purge()
+
+	assert.True(t, col.Trashed().Empty())
+	assert.Equal(t, 0, col.Restore(func(i int, value int) bool {
+		return true
+	}))
+	assert.Equal(t, []int{1, 3}, col.Collection().All())
+}
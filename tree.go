@@ -0,0 +1,101 @@
+package collection
+
+// Node is a single node of a tree built by ToTree, holding an item from the
+// original collection along with its children.
+type Node[T comparable] struct {
+	Value    T
+	Children Collection[*Node[T]]
+}
+
+// ToTree builds a tree from a flat collection of items, where each item is
+// identified by the key returned by id, and refers to its parent using the key
+// returned by parent. Items for which parent returns false, or whose parent is
+// not in the collection, become root nodes. Items whose parents form a cycle
+// would otherwise be lost, so the cycle is broken by making its earliest item in
+// the collection a root node. The root nodes are returned, and both roots and
+// children keep the order of the original collection.
+func ToTree[T comparable, K comparable](c Collection[T], id func(v T) K, parent func(v T) (K, bool)) Collection[*Node[T]] {
+	indexes := make(map[K]int, c.Count())
+	nodes := make([]*Node[T], c.Count())
+	for i, v := range c.All() {
+		indexes[id(v)] = i
+		nodes[i] = &Node[T]{Value: v, Children: Make[*Node[T]]()}
+	}
+
+	// parents holds the index of each node's parent, or -1 for a root node.
+	parents := make([]int, len(nodes))
+	for i, node := range nodes {
+		parents[i] = -1
+		if key, ok := parent(node.Value); ok {
+			if p, found := indexes[key]; found && p != i {
+				parents[i] = p
+			}
+		}
+	}
+	breakTreeCycles(parents)
+
+	roots := Make[*Node[T]]()
+	for i, node := range nodes {
+		if parents[i] < 0 {
+			roots = roots.Append(node)
+			continue
+		}
+
+		p := nodes[parents[i]]
+		p.Children = p.Children.Append(node)
+	}
+
+	return roots
+}
+
+// breakTreeCycles follows the parent of each node until it reaches a root, and
+// turns the earliest node of each cycle it finds into a root.
+func breakTreeCycles(parents []int) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(parents))
+	for i := range parents {
+		var path []int
+		j := i
+		for j >= 0 && state[j] == unvisited {
+			state[j] = visiting
+			path = append(path, j)
+			j = parents[j]
+		}
+
+		if j >= 0 && state[j] == visiting {
+			earliest := j
+			for k := len(path) - 1; path[k] != j; k-- {
+				if path[k] < earliest {
+					earliest = path[k]
+				}
+			}
+			parents[earliest] = -1
+		}
+
+		for _, k := range path {
+			state[k] = visited
+		}
+	}
+}
+
+// FlattenTree flattens a tree built by ToTree back into a collection of items,
+// visiting each node before its children.
+func FlattenTree[T comparable](roots Collection[*Node[T]]) Collection[T] {
+	flat := Make[T]()
+
+	var visit func(nodes Collection[*Node[T]])
+	visit = func(nodes Collection[*Node[T]]) {
+		for _, node := range nodes.All() {
+			flat = flat.Append(node.Value)
+			visit(node.Children)
+		}
+	}
+	visit(roots)
+
+	return flat
+}
@@ -0,0 +1,56 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+type comment struct {
+	id     int
+	parent int
+}
+
+func commentID(c comment) int {
+	return c.id
+}
+
+func commentParent(c comment) (int, bool) {
+	return c.parent, c.parent != 0
+}
+
+func TestToTree(t *testing.T) {
+	comments := collection.From([]comment{{1, 0}, {2, 1}, {3, 0}, {4, 2}, {5, 1}, {6, 99}})
+
+	roots := collection.ToTree(comments, commentID, commentParent)
+
+	assert.Equal(t, 3, roots.Count())
+	assert.Equal(t, comment{1, 0}, roots.At(0).Value)
+	assert.Equal(t, comment{3, 0}, roots.At(1).Value)
+	assert.Equal(t, comment{6, 99}, roots.At(2).Value)
+
+	children := roots.At(0).Children
+	assert.Equal(t, 2, children.Count())
+	assert.Equal(t, comment{2, 1}, children.At(0).Value)
+	assert.Equal(t, comment{5, 1}, children.At(1).Value)
+	assert.Equal(t, comment{4, 2}, children.At(0).Children.First().Value)
+}
+
+func TestToTreeBreaksCycles(t *testing.T) {
+	comments := collection.From([]comment{{1, 0}, {2, 4}, {3, 2}, {4, 3}, {5, 3}})
+
+	roots := collection.ToTree(comments, commentID, commentParent)
+
+	assert.Equal(t, 2, roots.Count())
+	assert.Equal(t, comment{2, 4}, roots.At(1).Value)
+	assert.Equal(t, []comment{{1, 0}, {2, 4}, {3, 2}, {4, 3}, {5, 3}}, collection.FlattenTree(roots).All())
+}
+
+func TestFlattenTree(t *testing.T) {
+	comments := collection.From([]comment{{1, 0}, {2, 1}, {3, 0}, {4, 2}, {5, 1}})
+
+	flat := collection.FlattenTree(collection.ToTree(comments, commentID, commentParent))
+
+	assert.Equal(t, []comment{{1, 0}, {2, 1}, {4, 2}, {5, 1}, {3, 0}}, flat.All())
+}
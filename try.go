@@ -0,0 +1,112 @@
+package collection
+
+import "errors"
+
+// ErrOption configures how fallible operations, such as MapErr, handle errors.
+type ErrOption func(*errConfig)
+
+type errConfig struct {
+	collect bool
+}
+
+// CollectErrors makes a fallible operation, such as MapErr, carry on past items
+// that fail, returning every error joined together with errors.Join instead of
+// stopping at the first.
+func CollectErrors() ErrOption {
+	return func(c *errConfig) {
+		c.collect = true
+	}
+}
+
+func newErrConfig(opts []ErrOption) errConfig {
+	var cfg errConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+// MapErr works in the same way as Map, but fn may fail. By default, MapErr stops
+// at the first error and returns it as an *ItemError recording the index of the
+// item. With the CollectErrors option, every item is transformed, failed items
+// are left as zero values, and every error is returned.
+func (c Collection[T]) MapErr(fn func(i int, value T) (T, error), opts ...ErrOption) (Collection[T], error) {
+	return MapErr(c, fn, opts...)
+}
+
+// MapErr uses fn to transform each item in the collection into another type,
+// where fn may fail. Errors are handled in the same way as the MapErr method.
+func MapErr[T comparable, U comparable](c Collection[T], fn func(i int, value T) (U, error), opts ...ErrOption) (Collection[U], error) {
+	cfg := newErrConfig(opts)
+	results := make([]U, c.Count())
+	failures := []error{}
+
+	for i, v := range c.contents {
+		u, err := fn(i, v)
+		if err != nil {
+			if !cfg.collect {
+				return Collection[U]{}, &ItemError{Index: i, Err: err}
+			}
+
+			failures = append(failures, &ItemError{Index: i, Err: err})
+			continue
+		}
+
+		results[i] = u
+	}
+
+	return From(results), errors.Join(failures...)
+}
+
+// EachErr works in the same way as Each, but fn may fail. By default, EachErr
+// stops at the first error and returns it as an *ItemError recording the index
+// of the item. With the CollectErrors option, fn is called for every item and
+// every error is returned.
+func (c Collection[T]) EachErr(fn func(i int, value T) error, opts ...ErrOption) error {
+	cfg := newErrConfig(opts)
+	failures := []error{}
+
+	for i, v := range c.contents {
+		if err := fn(i, v); err != nil {
+			if !cfg.collect {
+				return &ItemError{Index: i, Err: err}
+			}
+
+			failures = append(failures, &ItemError{Index: i, Err: err})
+		}
+	}
+
+	return errors.Join(failures...)
+}
+
+// FilterErr works in the same way as Filter, but the predicate may fail. Errors
+// are handled in the same way as EachErr; with the CollectErrors option, items
+// for which the predicate fails are left out of the result.
+func (c Collection[T]) FilterErr(predicate func(i int, value T) (bool, error), opts ...ErrOption) (Collection[T], error) {
+	contents := make([]T, 0, c.Count())
+
+	err := c.EachErr(func(i int, value T) error {
+		keep, err := predicate(i, value)
+		if keep && err == nil {
+			contents = append(contents, value)
+		}
+
+		return err
+	}, opts...)
+
+	if err != nil && !newErrConfig(opts).collect {
+		return Collection[T]{}, err
+	}
+
+	return From(contents), err
+}
+
+// Validate calls fn for every item in the collection, and returns every error
+// it returns joined together, each as an *ItemError recording the index of the
+// item. This is the same as calling EachErr with the CollectErrors option, for
+// checking input where every bad item needs to be reported, not just the
+// first.
+func (c Collection[T]) Validate(fn func(i int, value T) error) error {
+	return c.EachErr(fn, CollectErrors())
+}
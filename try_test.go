@@ -0,0 +1,107 @@
+package collection_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapErr(t *testing.T) {
+	double := func(i int, value int) (int, error) {
+		if value < 0 {
+			return 0, errors.New("negative")
+		}
+		return value * 2, nil
+	}
+
+	res, err := collection.From([]int{1, 2, 3}).MapErr(double)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4, 6}, res.All())
+
+	res, err = collection.From([]int{1, -2, -3}).MapErr(double)
+	assert.EqualError(t, err, "item 1: negative")
+	assert.True(t, res.Empty())
+
+	res, err = collection.From([]int{1, -2, -3}).MapErr(double, collection.CollectErrors())
+	assert.EqualError(t, err, "item 1: negative\nitem 2: negative")
+	assert.Equal(t, []int{2, 0, 0}, res.All())
+}
+
+func TestMapErrOtherType(t *testing.T) {
+	parse := func(i int, value string) (int, error) {
+		return strconv.Atoi(value)
+	}
+
+	res, err := collection.MapErr(collection.From([]string{"1", "2"}), parse)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, res.All())
+
+	_, err = collection.MapErr(collection.From([]string{"1", "x"}), parse)
+	var itemErr *collection.ItemError
+	assert.True(t, errors.As(err, &itemErr))
+	assert.Equal(t, 1, itemErr.Index)
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+}
+
+func TestEachErr(t *testing.T) {
+	visited := []int{}
+	check := func(i int, value int) error {
+		visited = append(visited, value)
+		if value%2 == 0 {
+			return errors.New("even")
+		}
+		return nil
+	}
+
+	assert.NoError(t, collection.From([]int{1, 3}).EachErr(check))
+
+	visited = []int{}
+	err := collection.From([]int{1, 2, 3, 4}).EachErr(check)
+	assert.EqualError(t, err, "item 1: even")
+	assert.Equal(t, []int{1, 2}, visited)
+
+	visited = []int{}
+	err = collection.From([]int{1, 2, 3, 4}).EachErr(check, collection.CollectErrors())
+	assert.EqualError(t, err, "item 1: even\nitem 3: even")
+	assert.Equal(t, []int{1, 2, 3, 4}, visited)
+}
+
+func TestFilterErr(t *testing.T) {
+	small := func(i int, value string) (bool, error) {
+		n, err := strconv.Atoi(value)
+		return n < 10, err
+	}
+
+	res, err := collection.From([]string{"1", "20", "3"}).FilterErr(small)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "3"}, res.All())
+
+	res, err = collection.From([]string{"1", "x", "3"}).FilterErr(small)
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	assert.True(t, res.Empty())
+
+	res, err = collection.From([]string{"1", "x", "3"}).FilterErr(small, collection.CollectErrors())
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	assert.Equal(t, []string{"1", "3"}, res.All())
+}
+
+func TestValidate(t *testing.T) {
+	notEmpty := func(i int, value string) error {
+		if value == "" {
+			return errors.New("empty")
+		}
+		return nil
+	}
+
+	assert.NoError(t, collection.From([]string{"a", "b"}).Validate(notEmpty))
+
+	err := collection.From([]string{"", "b", ""}).Validate(notEmpty)
+	assert.EqualError(t, err, "item 0: empty\nitem 2: empty")
+
+	var itemErr *collection.ItemError
+	assert.True(t, errors.As(err, &itemErr))
+	assert.Equal(t, 0, itemErr.Index)
+}
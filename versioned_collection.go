@@ -0,0 +1,165 @@
+package collection
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// VersionedCollection is a collection that counts every change made to it as a
+// new version, and keeps a log of those changes so that the difference between
+// versions can be persisted or replicated as a delta, rather than as a full
+// snapshot.
+type VersionedCollection[T comparable] struct {
+	c       Collection[T]
+	codec   Codec[T]
+	version uint64
+	// base is the version before the first change held in log.
+	base uint64
+	log  [][]byte
+}
+
+// NewVersioned returns a new VersionedCollection at version 0, containing a copy
+// of the given collection's items. Changes are encoded using the given codec.
+func NewVersioned[T comparable](c Collection[T], codec Codec[T]) *VersionedCollection[T] {
+	return &VersionedCollection[T]{c: c.Clone(), codec: codec}
+}
+
+// Collection returns the collection's current items.
+func (v *VersionedCollection[T]) Collection() Collection[T] {
+	return v.c
+}
+
+// Version returns the collection's current version.
+func (v *VersionedCollection[T]) Version() uint64 {
+	return v.version
+}
+
+// record applies a change to the collection and adds it to the log.
+func (v *VersionedCollection[T]) record(op string, n int, value *T) error {
+	entry, err := encodeEntry(v.codec, op, n, value)
+	if err != nil {
+		return err
+	}
+
+	if err := applyEntry(&v.c, v.codec, entry[:len(entry)-1]); err != nil {
+		return err
+	}
+
+	v.log = append(v.log, entry)
+	v.version++
+
+	return nil
+}
+
+// Append adds the given values to the end of the collection, as one version per
+// value.
+func (v *VersionedCollection[T]) Append(values ...T) error {
+	for i := range values {
+		if err := v.record(journalAppend, 0, &values[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Set updates the value at the given index to value. If the index is the
+// collection's length, the value is appended. If the index is negative or
+// beyond the end of the collection, a *collection.IndexError is returned and no
+// new version is recorded.
+func (v *VersionedCollection[T]) Set(index int, value T) error {
+	return v.record(journalSet, index, &value)
+}
+
+// Pop removes count items from the end of the collection. If there are fewer
+// than count items, an *IndexError wrapping collection.ErrIndexOutOfRange is
+// returned.
+func (v *VersionedCollection[T]) Pop(count int) error {
+	if count < 0 || count > v.c.Count() {
+		return &IndexError{Index: count, Len: v.c.Count(), Err: ErrIndexOutOfRange}
+	}
+
+	return v.record(journalPop, count, nil)
+}
+
+// RemoveAt removes the item at the given index. If the index does not exist in
+// the collection, collection.ErrIndexOutOfRange is returned.
+func (v *VersionedCollection[T]) RemoveAt(index int) error {
+	if _, err := v.c.SafeRemoveAt(index); err != nil {
+		return err
+	}
+
+	return v.record(journalRemoveAt, index, nil)
+}
+
+// DeltaSince encodes every change made since the given version, so that a copy
+// of the collection at that version can be brought up to date using ApplyDelta.
+// An error wrapping collection.ErrVersionUnavailable is returned if the version
+// is in the future, or its changes have been discarded by Forget.
+func (v *VersionedCollection[T]) DeltaSince(version uint64) ([]byte, error) {
+	if version > v.version || version < v.base {
+		return nil, fmt.Errorf("%w: %d is not between %d and %d", ErrVersionUnavailable, version, v.base, v.version)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "delta\t%d\t%d\n", version, v.version)
+	for _, entry := range v.log[version-v.base:] {
+		b.Write(entry)
+	}
+
+	return b.Bytes(), nil
+}
+
+// ApplyDelta applies a delta produced by DeltaSince to the collection. The delta
+// must start at the collection's current version, otherwise an error wrapping
+// collection.ErrVersionMismatch is returned.
+func (v *VersionedCollection[T]) ApplyDelta(delta []byte) error {
+	lines := bytes.Split(bytes.TrimSuffix(delta, []byte("\n")), []byte("\n"))
+
+	var from, to uint64
+	if _, err := fmt.Sscanf(string(lines[0]), "delta\t%d\t%d", &from, &to); err != nil {
+		return fmt.Errorf("%w: invalid delta header", ErrCorruptJournal)
+	}
+
+	if from != v.version {
+		return fmt.Errorf("%w: delta starts at %d, collection is at %d", ErrVersionMismatch, from, v.version)
+	}
+
+	entries := lines[1:]
+	if to < from || uint64(len(entries)) != to-from {
+		return fmt.Errorf("%w: expected %d changes, got %d", ErrCorruptJournal, to-from, len(entries))
+	}
+
+	// Apply the changes to a copy first, so a bad delta leaves the collection
+	// untouched.
+	c := v.c.Clone()
+	for i, entry := range entries {
+		if err := applyEntry(&c, v.codec, entry); err != nil {
+			return fmt.Errorf("%w: change %d: %v", ErrCorruptJournal, i, err)
+		}
+	}
+
+	v.c = c
+	for _, entry := range entries {
+		v.log = append(v.log, append(append([]byte{}, entry...), '\n'))
+	}
+	v.version = to
+
+	return nil
+}
+
+// Forget discards the logged changes made before the given version, once every
+// copy of the collection has been brought past it. Deltas can no longer be
+// produced from versions before it.
+func (v *VersionedCollection[T]) Forget(before uint64) {
+	if before <= v.base {
+		return
+	}
+
+	if before > v.version {
+		before = v.version
+	}
+
+	v.log = append([][]byte{}, v.log[before-v.base:]...)
+	v.base = before
+}
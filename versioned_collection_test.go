@@ -0,0 +1,88 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionedCollectionDeltas(t *testing.T) {
+	codec := collection.JSONCodec[string]{}
+	primary := collection.NewVersioned[string](collection.From([]string{"a"}), codec)
+	replica := collection.NewVersioned[string](collection.From([]string{"a"}), codec)
+
+	assert.NoError(t, primary.Append("b", "c"))
+	assert.NoError(t, primary.Set(0, "z"))
+	assert.Equal(t, uint64(3), primary.Version())
+
+	delta, err := primary.DeltaSince(0)
+	assert.NoError(t, err)
+	assert.NoError(t, replica.ApplyDelta(delta))
+	assert.Equal(t, primary.Collection().All(), replica.Collection().All())
+	assert.Equal(t, uint64(3), replica.Version())
+
+	assert.NoError(t, primary.RemoveAt(1))
+	assert.NoError(t, primary.Pop(1))
+
+	delta, err = primary.DeltaSince(replica.Version())
+	assert.NoError(t, err)
+	assert.NoError(t, replica.ApplyDelta(delta))
+	assert.Equal(t, []string{"z"}, replica.Collection().All())
+
+	delta, err = primary.DeltaSince(primary.Version())
+	assert.NoError(t, err)
+	assert.NoError(t, replica.ApplyDelta(delta))
+}
+
+func TestVersionedCollectionRejectsMismatchedDeltas(t *testing.T) {
+	codec := collection.JSONCodec[int]{}
+	primary := collection.NewVersioned[int](collection.Make[int](), codec)
+	replica := collection.NewVersioned[int](collection.Make[int](), codec)
+
+	primary.Append(1, 2)
+	delta, _ := primary.DeltaSince(1)
+
+	assert.ErrorIs(t, replica.ApplyDelta(delta), collection.ErrVersionMismatch)
+	assert.ErrorIs(t, replica.ApplyDelta([]byte("nonsense")), collection.ErrCorruptJournal)
+	assert.ErrorIs(t, replica.ApplyDelta([]byte("delta\t0\t1\nset\t-1\tMQ==\n")), collection.ErrCorruptJournal)
+	assert.ErrorIs(t, replica.ApplyDelta([]byte("delta\t0\t1\nset\t4611686018427387904\tMQ==\n")), collection.ErrCorruptJournal)
+	assert.True(t, replica.Collection().Empty())
+	assert.Equal(t, uint64(0), replica.Version())
+}
+
+func TestVersionedCollectionRejectsInvalidSet(t *testing.T) {
+	v := collection.NewVersioned[int](collection.Make[int](), collection.JSONCodec[int]{})
+
+	var indexErr *collection.IndexError
+	assert.ErrorAs(t, v.Set(-1, 1), &indexErr)
+	assert.Equal(t, -1, indexErr.Index)
+	assert.ErrorIs(t, v.Set(1<<62, 1), collection.ErrIndexOutOfRange)
+	assert.Equal(t, uint64(0), v.Version())
+}
+
+func TestVersionedCollectionRejectsInvalidPop(t *testing.T) {
+	v := collection.NewVersioned[int](collection.From([]int{1}), collection.JSONCodec[int]{})
+
+	var indexErr *collection.IndexError
+	assert.ErrorAs(t, v.Pop(2), &indexErr)
+	assert.Equal(t, 2, indexErr.Index)
+	assert.Equal(t, 1, indexErr.Len)
+	assert.ErrorIs(t, indexErr, collection.ErrIndexOutOfRange)
+}
+
+func TestVersionedCollectionForget(t *testing.T) {
+	v := collection.NewVersioned[int](collection.Make[int](), collection.JSONCodec[int]{})
+	v.Append(1, 2, 3)
+	v.Forget(2)
+
+	_, err := v.DeltaSince(1)
+	assert.ErrorIs(t, err, collection.ErrVersionUnavailable)
+
+	_, err = v.DeltaSince(4)
+	assert.ErrorIs(t, err, collection.ErrVersionUnavailable)
+
+	delta, err := v.DeltaSince(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "delta\t2\t3\nappend\t0\tMw==\n", string(delta))
+}
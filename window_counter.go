@@ -0,0 +1,70 @@
+package collection
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// WindowCounter counts events within a sliding window of time, for lightweight
+// rate limiting and statistics. Events older than the window are evicted
+// automatically. It is safe for concurrent use.
+type WindowCounter struct {
+	events *TimedCollection[struct{}]
+	window time.Duration
+}
+
+// NewWindowCounter returns a new WindowCounter that remembers events for the
+// given window. The options are passed to the underlying TimedCollection, so
+// WithClock can be used to control the current time. NewWindowCounter panics if
+// the window is not positive.
+func NewWindowCounter(window time.Duration, opts ...TimedOption) *WindowCounter {
+	if window <= 0 {
+		panic(fmt.Sprintf("collection: NewWindowCounter called with non-positive window %v", window))
+	}
+
+	return &WindowCounter{
+		events: NewTimed[struct{}](append(opts, WithMaxAge(window))...),
+		window: window,
+	}
+}
+
+// Record records a single event at the current time.
+func (w *WindowCounter) Record() {
+	w.RecordN(1)
+}
+
+// RecordN records n events at the current time.
+func (w *WindowCounter) RecordN(n int) {
+	if n < 1 {
+		return
+	}
+
+	w.events.Add(make([]struct{}, n)...)
+}
+
+// CountWithin returns the number of events recorded within the last d. Events
+// older than the counter's window have already been forgotten, so d is limited
+// to the window.
+func (w *WindowCounter) CountWithin(d time.Duration) int {
+	w.events.mu.Lock()
+	defer w.events.mu.Unlock()
+
+	entries := w.events.read()
+	cutoff := w.events.now().Add(-d)
+
+	return len(entries) - sort.Search(len(entries), func(i int) bool {
+		return !entries[i].At.Before(cutoff)
+	})
+}
+
+// Count returns the number of events recorded within the counter's window.
+func (w *WindowCounter) Count() int {
+	return w.events.Count()
+}
+
+// RatePerSecond returns the average number of events per second across the
+// counter's window.
+func (w *WindowCounter) RatePerSecond() float64 {
+	return float64(w.Count()) / w.window.Seconds()
+}
@@ -0,0 +1,54 @@
+package collection_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gostalt/collection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowCounter(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	counter := collection.NewWindowCounter(10*time.Second, collection.WithClock(clock.Now))
+
+	counter.RecordN(5)
+	clock.Advance(4 * time.Second)
+	counter.Record()
+	counter.Record()
+	clock.Advance(2 * time.Second)
+
+	assert.Equal(t, 7, counter.Count())
+	assert.Equal(t, 2, counter.CountWithin(3*time.Second))
+	assert.Equal(t, 0.7, counter.RatePerSecond())
+
+	clock.Advance(5 * time.Second)
+	assert.Equal(t, 2, counter.Count())
+	assert.Equal(t, 0.2, counter.RatePerSecond())
+}
+
+func TestWindowCounterCountDoesNotAllocate(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	counter := collection.NewWindowCounter(time.Second, collection.WithClock(clock.Now))
+	for i := 0; i < 1000; i++ {
+		counter.Record()
+		clock.Advance(time.Millisecond)
+	}
+
+	// Each call evicts one event, which shouldn't copy the rest.
+	allocs := testing.AllocsPerRun(100, func() {
+		clock.Advance(time.Millisecond)
+		counter.Count()
+	})
+
+	assert.Equal(t, 0.0, allocs)
+}
+
+func TestNewWindowCounterRejectsNonPositiveWindow(t *testing.T) {
+	assert.Panics(t, func() {
+		collection.NewWindowCounter(0)
+	})
+	assert.Panics(t, func() {
+		collection.NewWindowCounter(-time.Second)
+	})
+}